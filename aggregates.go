@@ -0,0 +1,197 @@
+package tablewriter
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+type (
+	// Aggregate computes the value of a footer cell from the raw values of a column.
+	Aggregate func(values []string) string
+
+	// FooterRow is a row of the footer computed from the rows of the table, with an Aggregate for some columns,
+	// by column index.
+	//
+	// Columns without an Aggregate are left blank.
+	FooterRow map[int]Aggregate
+
+	// NumberFormat renders a number computed by an Aggregate.
+	NumberFormat func(float64) string
+)
+
+// WithFooterRows appends rows computed from the data to the footer, e.g. totals and averages.
+//
+// Computed rows are rendered after the footer specified by WithFooter, if any, with the same formatting.
+//
+// Example:
+//
+//	WithFooterRows(
+//		FooterRow{0: Label("Total"), 2: Sum(nil)},
+//		FooterRow{0: Label("Average"), 2: Average(nil)},
+//	)
+func WithFooterRows(rows ...FooterRow) Option {
+	return func(o *options) {
+		o.footerRows = append(o.footerRows, rows...)
+	}
+}
+
+// Label is an Aggregate that yields a static value, e.g. the title of a footer row.
+func Label(value string) Aggregate {
+	return func(_ []string) string {
+		return value
+	}
+}
+
+// Sum is an Aggregate that adds up the numeric values of a column.
+//
+// Non-numeric values are ignored. Thousands separators, leading currency symbols and trailing percent signs are
+// ignored when parsing numbers. A nil format renders numbers with at most 2 decimals.
+func Sum(format NumberFormat) Aggregate {
+	return numericAggregate(format, func(numbers []float64) float64 {
+		var sum float64
+		for _, n := range numbers {
+			sum += n
+		}
+
+		return sum
+	})
+}
+
+// Average is an Aggregate that computes the mean of the numeric values of a column.
+//
+// Non-numeric values are ignored. A nil format renders numbers with at most 2 decimals.
+func Average(format NumberFormat) Aggregate {
+	return numericAggregate(format, func(numbers []float64) float64 {
+		var sum float64
+		for _, n := range numbers {
+			sum += n
+		}
+
+		return sum / float64(len(numbers))
+	})
+}
+
+// Min is an Aggregate that yields the lowest numeric value of a column.
+//
+// Non-numeric values are ignored. A nil format renders numbers with at most 2 decimals.
+func Min(format NumberFormat) Aggregate {
+	return numericAggregate(format, func(numbers []float64) float64 {
+		lowest := math.Inf(1)
+		for _, n := range numbers {
+			lowest = math.Min(lowest, n)
+		}
+
+		return lowest
+	})
+}
+
+// Max is an Aggregate that yields the highest numeric value of a column.
+//
+// Non-numeric values are ignored. A nil format renders numbers with at most 2 decimals.
+func Max(format NumberFormat) Aggregate {
+	return numericAggregate(format, func(numbers []float64) float64 {
+		highest := math.Inf(-1)
+		for _, n := range numbers {
+			highest = math.Max(highest, n)
+		}
+
+		return highest
+	})
+}
+
+// Count is an Aggregate that counts the non-blank values of a column.
+func Count() Aggregate {
+	return func(values []string) string {
+		count := 0
+		for _, value := range values {
+			if len(strings.TrimSpace(value)) > 0 {
+				count++
+			}
+		}
+
+		return strconv.Itoa(count)
+	}
+}
+
+// DistinctCount is an Aggregate that counts the distinct non-blank values of a column.
+//
+// Leading and trailing blanks are ignored when comparing values.
+func DistinctCount() Aggregate {
+	return func(values []string) string {
+		distinct := make(map[string]struct{}, len(values))
+		for _, value := range values {
+			if value = strings.TrimSpace(value); len(value) > 0 {
+				distinct[value] = struct{}{}
+			}
+		}
+
+		return strconv.Itoa(len(distinct))
+	}
+}
+
+// numericAggregate builds an Aggregate from a computation over the numeric values of a column.
+//
+// Columns without any numeric value yield a blank cell.
+func numericAggregate(format NumberFormat, compute func([]float64) float64) Aggregate {
+	if format == nil {
+		format = defaultNumberFormat
+	}
+
+	return func(values []string) string {
+		numbers := make([]float64, 0, len(values))
+		for _, value := range values {
+			if n, ok := parseNumber(value); ok {
+				numbers = append(numbers, n)
+			}
+		}
+
+		if len(numbers) == 0 {
+			return ""
+		}
+
+		return format(compute(numbers))
+	}
+}
+
+func defaultNumberFormat(n float64) string {
+	const precision = 100
+
+	return strconv.FormatFloat(math.Round(n*precision)/precision, 'f', -1, 64)
+}
+
+// prepareFooterRows determines the values of the rows of the footer computed from the data, after the footer
+// specified by WithFooter.
+func (t *Table) prepareFooterRows() {
+	for _, footerRow := range t.footerRows {
+		values := make([]string, t.numColumns)
+		for col, aggregate := range footerRow {
+			if col < 0 || col >= t.numColumns || aggregate == nil {
+				continue
+			}
+
+			values[col] = aggregate(t.columnValues(col, nil))
+		}
+
+		t.footerValues = append(t.footerValues, values)
+	}
+}
+
+// columnValues yields the raw values of a column, for some rows. With nil rows, all rows are considered.
+func (t *Table) columnValues(col int, rows []int) []string {
+	if rows == nil {
+		rows = make([]int, len(t.rows))
+		for i := range rows {
+			rows[i] = i
+		}
+	}
+
+	values := make([]string, 0, len(rows))
+	for _, i := range rows {
+		if row := t.rows[i]; col < len(row) {
+			values = append(values, row[col])
+		}
+	}
+
+	return values
+}
@@ -0,0 +1,107 @@
+package tablewriter
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregates(t *testing.T) {
+	t.Parallel()
+
+	values := []string{"1,200", " 300.5", "$87", "", "n/a", "87"}
+
+	t.Run("should compute aggregates over numeric values", func(t *testing.T) {
+		require.Equal(t, "1674.5", Sum(nil)(values))
+		require.Equal(t, "418.63", Average(nil)(values))
+		require.Equal(t, "87", Min(nil)(values))
+		require.Equal(t, "1200", Max(nil)(values))
+		require.Equal(t, "$1674.50", Sum(func(v float64) string { return fmt.Sprintf("$%.2f", v) })(values))
+	})
+
+	t.Run("should yield a blank value without numeric values", func(t *testing.T) {
+		require.Empty(t, Sum(nil)([]string{"n/a", ""}))
+		require.Empty(t, Average(nil)(nil))
+	})
+
+	t.Run("should count values", func(t *testing.T) {
+		require.Equal(t, "5", Count()(values))
+		require.Equal(t, "5", DistinctCount()(values))
+		require.Equal(t, "2", DistinctCount()([]string{"a", " a", "b", ""}))
+		require.Equal(t, "Total", Label("Total")(values))
+	})
+}
+
+func TestFooterRows(t *testing.T) {
+	t.Parallel()
+
+	options := func(opts ...Option) []Option {
+		return append([]Option{
+			WithHeader([]string{"Region", "Product", "Sales"}),
+			WithRows([][]string{{"eu", "apple", "1,200"}, {"eu", "pear", "300.5"}, {"us", "apple", "$87"}}),
+			WithFooterRows(
+				FooterRow{0: Label("Total"), 1: DistinctCount(), 2: Sum(nil)},
+				FooterRow{0: Label("Average"), 2: Average(func(v float64) string { return fmt.Sprintf("%.1f", v) })},
+			),
+		}, opts...)
+	}
+
+	t.Run("should render computed rows of the footer", func(t *testing.T) {
+		table, buf := NewBuffered(options(WithStyle(StyleLight))...)
+		require.NoError(t, table.Render())
+
+		const want = `┌─────────┬─────────┬────────┐
+│ REGION  │ PRODUCT │ SALES  │
+├─────────┼─────────┼────────┤
+│ eu      │ apple   │  1,200 │
+│ eu      │ pear    │  300.5 │
+│ us      │ apple   │    $87 │
+├─────────┼─────────┼────────┤
+│  TOTAL  │    2    │ 1587.5 │
+│ AVERAGE │           529.2  │
+└─────────┴─────────┴────────┘
+`
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should render computed rows after the static footer", func(t *testing.T) {
+		table, buf := NewBuffered(options(
+			WithFooter([]string{"", "", "k€"}),
+			WithFormat(FormatCSV),
+			WithCSVFooter(true),
+		)...)
+		require.NoError(t, table.Render())
+
+		const want = `Region,Product,Sales
+eu,apple,"1,200"
+eu,pear,300.5
+us,apple,$87
+,,k€
+Total,2,1587.5
+Average,,529.2
+`
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should interrupt the bottom line below blank cells of the last row", func(t *testing.T) {
+		table, buf := NewBuffered(
+			WithHeader([]string{"a", "b"}),
+			WithRows([][]string{{"1", "2"}, {"3", "4"}}),
+			WithFooterRows(FooterRow{0: Label("Sum"), 1: Sum(nil)}, FooterRow{1: Max(nil)}),
+		)
+		require.NoError(t, table.Render())
+
+		const want = `+-----+---+
+|  A  | B |
++-----+---+
+|   1 | 2 |
+|   3 | 4 |
++-----+---+
+| SUM | 6 |
+|       4 |
++-----+---+
+`
+		checkEqual(t, buf.String(), want)
+	})
+}
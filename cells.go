@@ -0,0 +1,273 @@
+package tablewriter
+
+import (
+	"strings"
+
+	"github.com/logrusorgru/aurora/v4"
+)
+
+// Cell is the content of a cell in a row, with its own formatting.
+type Cell struct {
+	Value string
+
+	// Formatter applies to this cell only and takes precedence over column formatters (see WithColFormatters).
+	Formatter Formatter
+
+	// Align applies to this cell only. AlignDefault retains the alignment of the column.
+	Align HAlignment
+
+	// VAlign applies to this cell only. VAlignDefault retains the vertical alignment of the column.
+	VAlign VAlignment
+
+	// Link is the target of a hyperlink on the value of the cell, e.g. a URL.
+	//
+	// On terminals, the value is rendered as a clickable label (OSC 8 hyperlink).
+	Link string
+
+	// ColSpan is the number of columns spanned by this cell, e.g. for a section label across the whole row.
+	//
+	// The following cells of the row start after the span. Spans are clipped to the columns of the table.
+	ColSpan int
+
+	// RowSpan is the number of rows spanned by this cell.
+	//
+	// The following rows omit the cells covered by the span, like HTML tables.
+	RowSpan int
+}
+
+// Hyperlink builds a cell rendered as a hyperlink: the label is displayed and links to the target.
+//
+// Only the label is accounted for in the width of the column. When hyperlinks are disabled (see WithHyperlinks),
+// the cell is rendered as "label (target)".
+func Hyperlink(label, target string) Cell {
+	return Cell{Value: label, Link: target}
+}
+
+// AppendRich appends a row to the table, with individual formatting for each cell.
+//
+// This may be used to highlight some values, or to render cells spanning several columns or rows.
+//
+// Cells spanning several rows or columns are not merged with WithMergeCells.
+func (t *Table) AppendRich(cells []Cell) {
+	rowIdx := len(t.rows)
+	covered := t.coveredColumns(rowIdx)
+
+	// cells are laid out by column, skipping the columns covered by spans
+	laidOut := make([]Cell, 0, len(cells))
+	for _, cell := range cells {
+		for covered[len(laidOut)] {
+			laidOut = append(laidOut, Cell{})
+		}
+
+		if cell.RowSpan > 1 {
+			t.rowSpans = append(t.rowSpans, cellSpan{
+				cellPos: cellPos{row: rowIdx, col: len(laidOut)},
+				rows:    cell.RowSpan,
+				cols:    max(cell.ColSpan, 1),
+			})
+		}
+
+		laidOut = append(laidOut, cell)
+		for i := 1; i < cell.ColSpan; i++ {
+			laidOut = append(laidOut, Cell{})
+		}
+	}
+
+	row := make([]string, len(laidOut))
+	for i, cell := range laidOut {
+		row[i] = cell.Value
+	}
+
+	if t.richCells == nil {
+		t.richCells = make(map[int][]Cell)
+	}
+
+	t.richCells[rowIdx] = laidOut
+	t.rows = append(t.rows, row)
+}
+
+// rowCells yields the individually formatted cells of a row, if any.
+func (t *Table) rowCells(row int) []Cell {
+	return t.richCells[row]
+}
+
+// cellLink yields the target of the hyperlink in a cell, if any.
+//
+// Links are retained even when hyperlinks are disabled on terminals, e.g. for HTML or markdown.
+func (t *Table) cellLink(row, col int) string {
+	row, col = t.spanOrigin(row, col)
+	if cells := t.rowCells(row); col < len(cells) {
+		return cells[col].Link
+	}
+
+	return ""
+}
+
+// linkLine renders a line of a cell as a terminal hyperlink, if the cell has a link.
+//
+// Each line of a multi-lines cell is rendered as a separate link to the same target.
+func (t *Table) linkLine(row, col int, line string) string {
+	if t.noHyperlinks {
+		return line
+	}
+
+	target := t.cellLink(row, col)
+	if len(target) == 0 || len(strings.TrimSpace(line)) == 0 {
+		return line
+	}
+
+	return aurora.Hyperlink(line, target).String()
+}
+
+// linkFallback renders the hyperlinks of a row as plain text, like "label (target)", when hyperlinks are disabled
+// in text tables.
+func (t *Table) linkFallback(row int, values []string) []string {
+	if !t.noHyperlinks || t.format != FormatText {
+		return values
+	}
+
+	for col, cell := range t.rowCells(row) {
+		if col < len(values) && len(cell.Link) > 0 {
+			values[col] = cell.Value + " (" + cell.Link + ")"
+		}
+	}
+
+	return values
+}
+
+// linkColumns renders the lines of the cells of a row with hyperlinks, if any.
+func (t *Table) linkColumns(row int, columns [][]string) [][]string {
+	if t.noHyperlinks || (len(t.rowCells(row)) == 0 && len(t.spans) == 0) {
+		return columns
+	}
+
+	linked := make([][]string, len(columns))
+	for col, lines := range columns {
+		linked[col] = lines
+		if len(t.cellLink(row, col)) == 0 {
+			continue
+		}
+
+		linked[col] = make([]string, len(lines))
+		for i, line := range lines {
+			linked[col][i] = t.linkLine(row, col, line)
+		}
+	}
+
+	return linked
+}
+
+// cellFormatter yields the formatter for a cell, if any.
+//
+// Individually formatted cells take precedence over rules, which take precedence over the formatters of the columns.
+func (t *Table) cellFormatter(row, col int) Formatter {
+	row, col = t.spanOrigin(row, col)
+	if cells := t.rowCells(row); col < len(cells) && cells[col].Formatter != nil {
+		return cells[col].Formatter
+	}
+
+	if formatter := t.ruleFormatter(row, col); formatter != nil {
+		return formatter
+	}
+
+	return t.columnsParams[col]
+}
+
+// rowFormatters yields the formatters for all the cells of a row, including the zebra stripe of the row.
+//
+// Cells spanning several rows keep the zebra stripe of the row where they start.
+func (t *Table) rowFormatters(row int) map[int]Formatter {
+	band := t.rowBand(row)
+	if len(t.rowCells(row)) == 0 && len(t.rules) == 0 && band == nil && len(t.spans) == 0 {
+		return t.columnsParams
+	}
+
+	formatters := make(map[int]Formatter, t.numColumns)
+	for col := 0; col < t.numColumns; col++ {
+		if formatter := chainFormatters(t.cellBand(row, col), t.cellFormatter(row, col)); formatter != nil {
+			formatters[col] = formatter
+		}
+	}
+
+	return formatters
+}
+
+// cellAlignment yields the alignment of a cell.
+func (t *Table) cellAlignment(row, col int) HAlignment {
+	row, col = t.spanOrigin(row, col)
+	if cells := t.rowCells(row); col < len(cells) && cells[col].Align != AlignDefault {
+		return cells[col].Align
+	}
+
+	return t.columnsAlign[col]
+}
+
+// cellVAlignment yields the vertical alignment of a cell: the alignment of the cell if specified,
+// then the one of the column, then the one of the table.
+//
+// Works also for header and footer with special row indices.
+func (t *Table) cellVAlignment(row, col int) VAlignment {
+	row, col = t.spanOrigin(row, col)
+
+	var align VAlignment
+	if cells := t.rowCells(row); row >= 0 && col < len(cells) {
+		align = cells[col].VAlign
+	} else if level := headerGroupRowIdx(0) - row; level >= 0 && level < len(t.groups) {
+		align = t.groups[level][col].VAlign
+	}
+
+	if align != VAlignDefault {
+		return align
+	}
+
+	if align = t.perColumnVAlign[col]; align != VAlignDefault {
+		return align
+	}
+
+	return t.vAlign
+}
+
+// rowVAligner yields the vertical alignment of the cells of a row.
+func (t *Table) rowVAligner(row int) func(col int) VAlignment {
+	return func(col int) VAlignment {
+		return t.cellVAlignment(row, col)
+	}
+}
+
+// rowAligner yields the alignment of the cells of a row.
+func (t *Table) rowAligner(row int) colAligner {
+	return func(col int) padFunc {
+		return t.cellAlignment(row, col).padder()
+	}
+}
+
+// cellBand yields the formatter of the zebra stripe for a cell, if any.
+func (t *Table) cellBand(row, col int) Formatter {
+	row, _ = t.spanOrigin(row, col)
+
+	return t.rowBand(row)
+}
+
+// rowBand yields the formatter of the zebra stripe for a row, if any.
+func (t *Table) rowBand(row int) Formatter {
+	if t.stripeFormatter == nil {
+		return nil
+	}
+
+	every := t.stripeEvery
+	if every < 1 {
+		every = 1
+	}
+
+	position := row
+	if row >= 0 && row < len(t.rowPositions) {
+		// grouped rows are striped in the order of rendering
+		position = t.rowPositions[row]
+	}
+
+	if (position/every)%2 == 0 {
+		return nil
+	}
+
+	return t.stripeFormatter
+}
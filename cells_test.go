@@ -0,0 +1,232 @@
+package tablewriter
+
+import (
+	"testing"
+
+	"github.com/logrusorgru/aurora/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppendRich(t *testing.T) {
+	t.Parallel()
+
+	red := func(in interface{}) aurora.Value { return aurora.Red(in) }
+	highlight := func(in interface{}) aurora.Value { return aurora.Bold(in).Green() }
+
+	options := []Option{
+		WithHeader([]string{"Test", "Status"}),
+		WithColFormatters(map[int]Formatter{1: red}),
+	}
+
+	t.Run("should format individual cells, taking precedence over columns", func(t *testing.T) {
+		table, buf := NewBuffered(options...)
+		table.Append([]string{"alpha", "failed"})
+		table.AppendRich([]Cell{
+			{Value: "beta"},
+			{Value: "ok", Formatter: highlight, Align: AlignRight},
+		})
+		table.Append([]string{"gamma", "failed"})
+		require.NoError(t, table.Render())
+
+		const want = "+-------+--------+\n" +
+			"| TEST  | STATUS |\n" +
+			"+-------+--------+\n" +
+			"| alpha | \x1b[31mfailed\x1b[0m |\n" +
+			"| beta  | \x1b[1;32m    ok\x1b[0m |\n" +
+			"| gamma | \x1b[31mfailed\x1b[0m |\n" +
+			"+-------+--------+\n"
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should format individual cells when merging cells", func(t *testing.T) {
+		table, buf := NewBuffered(append(options, WithMergeCells(true))...)
+		table.AppendRich([]Cell{
+			{Value: "beta", Align: AlignCenter},
+			{Value: "ok", Formatter: highlight},
+		})
+		require.NoError(t, table.Render())
+
+		const want = "+------+--------+\n" +
+			"| TEST | STATUS |\n" +
+			"+------+--------+\n" +
+			"| beta | \x1b[1;32mok\x1b[0m     |\n" +
+			"+------+--------+\n"
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should render individual cells in HTML", func(t *testing.T) {
+		table, buf := NewBuffered(append(options, WithFormat(FormatHTML))...)
+		table.Append([]string{"alpha", "failed"})
+		table.AppendRich([]Cell{{Value: "beta", Align: AlignCenter}, {Value: "ok", Formatter: highlight}})
+		require.NoError(t, table.Render())
+
+		const want = `<table>
+  <thead>
+    <tr><th style="text-align: center">TEST</th><th style="text-align: center">STATUS</th></tr>
+  </thead>
+  <tbody>
+    <tr><td>alpha</td><td style="color: #cd0000">failed</td></tr>
+    <tr><td style="text-align: center">beta</td><td style="color: #00cd00; font-weight: bold">ok</td></tr>
+  </tbody>
+</table>
+`
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should clear individual cells with rows", func(t *testing.T) {
+		table, _ := NewBuffered(options...)
+		table.AppendRich([]Cell{{Value: "beta", Formatter: highlight}})
+		table.ClearRows()
+		table.Append([]string{"alpha"})
+
+		require.Nil(t, table.rowCells(0))
+		require.NotNil(t, table.cellFormatter(0, 1))
+	})
+}
+
+func TestHyperlinks(t *testing.T) {
+	t.Parallel()
+
+	const (
+		openLink  = "\x1b]8;;https://example.com/docs\x1b\\"
+		closeLink = "\x1b]8;;\x1b\\"
+	)
+
+	appendLinks := func(table *Table) {
+		table.AppendRich([]Cell{{Value: "api"}, Hyperlink("docs", "https://example.com/docs")})
+		table.Append([]string{"cli", "none"})
+	}
+
+	t.Run("should render links with OSC 8 sequences, measuring labels only", func(t *testing.T) {
+		table, buf := NewBuffered(WithHeader([]string{"Name", "Link"}))
+		appendLinks(table)
+		require.NoError(t, table.Render())
+
+		const want = "+------+------+\n" +
+			"| NAME | LINK |\n" +
+			"+------+------+\n" +
+			"| api  | " + openLink + "docs" + closeLink + " |\n" +
+			"| cli  | none |\n" +
+			"+------+------+\n"
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should render links when merging cells", func(t *testing.T) {
+		table, buf := NewBuffered(WithHeader([]string{"Name", "Link"}), WithMergeCells(true))
+		appendLinks(table)
+		require.NoError(t, table.Render())
+
+		require.Contains(t, buf.String(), "| api  | "+openLink+"docs"+closeLink+" |\n")
+	})
+
+	t.Run("should fall back to plain text when hyperlinks are disabled", func(t *testing.T) {
+		table, buf := NewBuffered(WithHeader([]string{"Name", "Link"}), WithHyperlinks(false))
+		appendLinks(table)
+		require.NoError(t, table.Render())
+
+		const want = "+------+----------------------------+\n" +
+			"| NAME |            LINK            |\n" +
+			"+------+----------------------------+\n" +
+			"| api  | docs                       |\n" +
+			"|      | (https://example.com/docs) |\n" +
+			"| cli  | none                       |\n" +
+			"+------+----------------------------+\n"
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should render links in markdown and HTML", func(t *testing.T) {
+		table, buf := NewBuffered(WithHeader([]string{"Name", "Link"}), WithFormat(FormatMarkdown))
+		appendLinks(table)
+		require.NoError(t, table.Render())
+		require.Contains(t, buf.String(), "| api  | [docs](https://example.com/docs) |\n")
+
+		table, buf = NewBuffered(WithHeader([]string{"Name", "Link"}), WithFormat(FormatHTML))
+		appendLinks(table)
+		require.NoError(t, table.Render())
+		require.Contains(t, buf.String(), `<td><a href="https://example.com/docs">docs</a></td>`)
+	})
+
+	t.Run("should retain links in other formats when hyperlinks are disabled", func(t *testing.T) {
+		options := []Option{WithHeader([]string{"Name", "Link"}), WithHyperlinks(false)}
+
+		table, buf := NewBuffered(append(options, WithFormat(FormatMarkdown))...)
+		appendLinks(table)
+		require.NoError(t, table.Render())
+		require.Contains(t, buf.String(), "| api  | [docs](https://example.com/docs) |\n")
+		require.Equal(t, [][]string{{"api", "docs"}, {"cli", "none"}}, table.Rows())
+
+		table, buf = NewBuffered(append(options, WithFormat(FormatHTML))...)
+		appendLinks(table)
+		require.NoError(t, table.Render())
+		require.Contains(t, buf.String(), `<td><a href="https://example.com/docs">docs</a></td>`)
+
+		table, buf = NewBuffered(append(options, WithFormat(FormatNDJSON))...)
+		appendLinks(table)
+		require.NoError(t, table.Render())
+		checkEqual(t, buf.String(), `{"NAME":"api","LINK":"docs"}`+"\n"+`{"NAME":"cli","LINK":"none"}`+"\n")
+	})
+}
+
+func TestZebraStripes(t *testing.T) {
+	t.Parallel()
+
+	band := func(in interface{}) aurora.Value { return aurora.BgIndex(236, in) }
+	red := func(in interface{}) aurora.Value { return aurora.Red(in) }
+
+	options := []Option{
+		WithHeader([]string{"Name", "Score"}),
+		WithRows([][]string{
+			{"A", "1"},
+			{"A", "2"},
+			{"B", "3"},
+			{"C", "4"},
+		}),
+		WithZebraStripes(band, 1),
+		WithColFormatters(map[int]Formatter{1: red}),
+	}
+
+	const (
+		on  = "\x1b[48;5;236m"
+		off = "\x1b[0m"
+	)
+
+	t.Run("should format every other row, including padding", func(t *testing.T) {
+		table, buf := NewBuffered(options...)
+		require.NoError(t, table.Render())
+
+		const want = "+------+-------+\n" +
+			"| NAME | SCORE |\n" +
+			"+------+-------+\n" +
+			"| A    | \x1b[31m    1" + off + " |\n" +
+			"|" + on + " " + off + on + "A   " + off + on + " " + off + "|" + on + " " + off + "\x1b[31;48;5;236m    2" + off + on + " " + off + "|\n" +
+			"| B    | \x1b[31m    3" + off + " |\n" +
+			"|" + on + " " + off + on + "C   " + off + on + " " + off + "|" + on + " " + off + "\x1b[31;48;5;236m    4" + off + on + " " + off + "|\n" +
+			"+------+-------+\n"
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should format bands of rows", func(t *testing.T) {
+		table, _ := NewBuffered(append(options, WithZebraStripes(band, 2))...)
+
+		require.Nil(t, table.rowBand(0))
+		require.Nil(t, table.rowBand(1))
+		require.NotNil(t, table.rowBand(2))
+		require.NotNil(t, table.rowBand(3))
+		require.Nil(t, table.rowBand(4))
+	})
+
+	t.Run("should keep the band of the row where merged cells start", func(t *testing.T) {
+		table, buf := NewBuffered(append(options, WithMergeCells(true))...)
+		require.NoError(t, table.Render())
+
+		const want = "+------+-------+\n" +
+			"| NAME | SCORE |\n" +
+			"+------+-------+\n" +
+			"| A    |     \x1b[31m1\x1b[0m |\n" +
+			"|      |" + on + " " + off + "\x1b[31;48;5;236m    2" + off + on + " " + off + "|\n" +
+			"| B    |     \x1b[31m3\x1b[0m |\n" +
+			"|" + on + " " + off + on + "C   " + off + on + " " + off + "|" + on + " " + off + "\x1b[31;48;5;236m    4" + off + on + " " + off + "|\n" +
+			"+------+-------+\n"
+		checkEqual(t, buf.String(), want)
+	})
+}
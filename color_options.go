@@ -0,0 +1,134 @@
+package tablewriter
+
+import (
+	"io"
+	"os"
+	"strings"
+)
+
+// ColorProfile describes the colors supported by the terminal where the table is rendered.
+type ColorProfile uint8
+
+// Supported color profiles.
+const (
+	// ColorProfileAuto detects the color profile from the environment and the output writer.
+	ColorProfileAuto ColorProfile = iota
+
+	// ColorProfileNone strips all ANSI escape sequences: formatters are ignored and hyperlinks
+	// are rendered as plain text.
+	ColorProfileNone
+
+	// ColorProfile16 downgrades colors to the 16 standard colors of a terminal.
+	ColorProfile16
+
+	// ColorProfile256 downgrades 24-bit colors to the 256-colors palette of a terminal.
+	ColorProfile256
+
+	// ColorProfileTrueColor renders escape sequences unaltered.
+	ColorProfileTrueColor
+)
+
+type colorOptions struct {
+	colorProfile ColorProfile
+}
+
+func defaultColorOptions() colorOptions {
+	return colorOptions{
+		colorProfile: ColorProfileAuto,
+	}
+}
+
+// WithColorProfile overrides the detection of the colors supported by the output, e.g. for tests.
+//
+// By default, the color profile is detected as follows, whatever the writer:
+//   - FORCE_COLOR enables colors, even when the output is not a terminal. FORCE_COLOR=0 disables colors,
+//     FORCE_COLOR=2 selects 256 colors and FORCE_COLOR=3 selects 24-bit colors
+//   - otherwise, a non-empty NO_COLOR disables colors
+//   - otherwise, writers other than files, such as buffers, render escape sequences unaltered
+//   - colors are disabled when writing to a file which is not a terminal, e.g. when the output is piped
+//   - on a terminal, the profile is inferred from the COLORTERM and TERM environment variables
+//
+// The color profile applies to text and markdown tables. Other formats are rendered unaltered.
+//
+// Colors are disabled with ColorProfileNone, which also renders hyperlinks as plain text.
+func WithColorProfile(profile ColorProfile) Option {
+	return func(o *options) {
+		o.colorProfile = profile
+	}
+}
+
+// resolveColorProfile detects the color profile, unless set explicitly.
+func (o *options) resolveColorProfile() {
+	if o.colorProfile == ColorProfileAuto {
+		o.colorProfile = detectColorProfile(o.out)
+	}
+
+	if o.colorProfile == ColorProfileNone {
+		o.noHyperlinks = true
+	}
+}
+
+// detectColorProfile determines the color profile from the environment and the output writer.
+func detectColorProfile(out io.Writer) ColorProfile {
+	if force, ok := os.LookupEnv("FORCE_COLOR"); ok {
+		switch strings.ToLower(strings.TrimSpace(force)) {
+		case "0", "false":
+			return ColorProfileNone
+		case "2":
+			return ColorProfile256
+		case "3":
+			return ColorProfileTrueColor
+		default:
+			if profile := terminalColorProfile(); profile > ColorProfile16 {
+				return profile
+			}
+
+			return ColorProfile16
+		}
+	}
+
+	if len(os.Getenv("NO_COLOR")) > 0 {
+		return ColorProfileNone
+	}
+
+	file, isFile := out.(*os.File)
+	if !isFile {
+		return ColorProfileTrueColor
+	}
+
+	if !isTerminal(file) {
+		return ColorProfileNone
+	}
+
+	return terminalColorProfile()
+}
+
+// terminalColorProfile infers the color profile of a terminal from the COLORTERM and TERM environment variables.
+func terminalColorProfile() ColorProfile {
+	switch strings.ToLower(os.Getenv("COLORTERM")) {
+	case "truecolor", "24bit":
+		return ColorProfileTrueColor
+	}
+
+	term := strings.ToLower(os.Getenv("TERM"))
+	switch {
+	case term == "dumb":
+		return ColorProfileNone
+	case strings.Contains(term, "truecolor") || strings.Contains(term, "24bit") || strings.Contains(term, "direct"):
+		return ColorProfileTrueColor
+	case strings.Contains(term, "256color"):
+		return ColorProfile256
+	default:
+		return ColorProfile16
+	}
+}
+
+// isTerminal tells if a file is a character device, such as a terminal.
+func isTerminal(file *os.File) bool {
+	info, err := file.Stat()
+	if err != nil {
+		return false
+	}
+
+	return info.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,169 @@
+package tablewriter
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// SGR parameters for colors
+const (
+	sgrFgExtended = 38
+	sgrBgExtended = 48
+	sgrIndexed    = 5
+	sgrRGB        = 2
+)
+
+var (
+	// sgrSequence matches SGR sequences (colors and text attributes)
+	sgrSequence = regexp.MustCompile("\033\\[([0-9;]*)m")
+
+	// escapeSequence matches all the ANSI escape sequences that may be found in a table:
+	// SGR sequences, line erasure and OSC 8 hyperlinks.
+	escapeSequence = regexp.MustCompile("\033\\[[0-9;]*[mK]|\033\\]8;[^\007\033]*(?:\007|\033\\\\)")
+)
+
+// standard 16 colors of a terminal, as rendered by xterm
+var baseColors = [16][3]uint8{
+	{0x00, 0x00, 0x00}, {0xcd, 0x00, 0x00}, {0x00, 0xcd, 0x00}, {0xcd, 0xcd, 0x00},
+	{0x00, 0x00, 0xee}, {0xcd, 0x00, 0xcd}, {0x00, 0xcd, 0xcd}, {0xe5, 0xe5, 0xe5},
+	{0x7f, 0x7f, 0x7f}, {0xff, 0x00, 0x00}, {0x00, 0xff, 0x00}, {0xff, 0xff, 0x00},
+	{0x5c, 0x5c, 0xff}, {0xff, 0x00, 0xff}, {0x00, 0xff, 0xff}, {0xff, 0xff, 0xff},
+}
+
+// levels of the 6x6x6 color cube of the 256-colors palette
+var cubeLevels = [6]uint8{0, 95, 135, 175, 215, 255}
+
+// convert adapts the escape sequences of rendered content to the color profile.
+func (p ColorProfile) convert(content []byte) []byte {
+	switch p {
+	case ColorProfileNone:
+		return escapeSequence.ReplaceAll(content, nil)
+	case ColorProfile16, ColorProfile256:
+		return sgrSequence.ReplaceAllFunc(content, func(sequence []byte) []byte {
+			params := sgrSequence.FindSubmatch(sequence)[1]
+
+			return []byte("\033[" + p.convertParams(string(params)) + "m")
+		})
+	default:
+		return content
+	}
+}
+
+// convertParams downgrades the extended colors in the parameters of a SGR sequence.
+func (p ColorProfile) convertParams(params string) string {
+	if !strings.Contains(params, "8;") {
+		// no extended color
+		return params
+	}
+
+	codes := strings.Split(params, ";")
+	converted := make([]string, 0, len(codes))
+
+	for i := 0; i < len(codes); i++ {
+		code, _ := strconv.Atoi(codes[i])
+		if (code != sgrFgExtended && code != sgrBgExtended) || i+1 >= len(codes) {
+			converted = append(converted, codes[i])
+
+			continue
+		}
+
+		var (
+			index int
+			ok    bool
+		)
+
+		switch mode, _ := strconv.Atoi(codes[i+1]); {
+		case mode == sgrIndexed && i+2 < len(codes):
+			index, _ = strconv.Atoi(codes[i+2])
+			ok = index >= 0 && index < 256
+			i += 2
+		case mode == sgrRGB && i+4 < len(codes):
+			var rgb [3]uint8
+			for j := range rgb {
+				c, _ := strconv.Atoi(codes[i+2+j])
+				rgb[j] = uint8(c)
+			}
+
+			// the 16 standard colors depend on the theme of the terminal: prefer the color cube and grayscale ramp
+			index = nearestColorIndex(rgb, 16, 256)
+			ok = true
+			i += 4
+		default:
+			// malformed: retain as is
+			converted = append(converted, codes[i])
+
+			continue
+		}
+
+		if !ok {
+			continue
+		}
+
+		if p == ColorProfile256 {
+			converted = append(converted, strconv.Itoa(code), strconv.Itoa(sgrIndexed), strconv.Itoa(index))
+
+			continue
+		}
+
+		converted = append(converted, strconv.Itoa(baseColorCode(code == sgrBgExtended, index)))
+	}
+
+	return strings.Join(converted, ";")
+}
+
+// baseColorCode yields the SGR code for one of the 16 standard colors nearest to a color of the 256-colors palette.
+func baseColorCode(background bool, index int) int {
+	if index >= 16 {
+		index = nearestColorIndex(colorIndexRGB(uint8(index)), 0, 16)
+	}
+
+	code := 30 + index
+	if index >= 8 {
+		code = 90 + index - 8
+	}
+
+	if background {
+		code += 10
+	}
+
+	return code
+}
+
+// nearestColorIndex yields the index of the nearest color in a range of the 256-colors palette.
+func nearestColorIndex(rgb [3]uint8, from, to int) int {
+	best, bestDistance := from, -1
+	for index := from; index < to; index++ {
+		candidate := colorIndexRGB(uint8(index))
+
+		distance := 0
+		for j := range rgb {
+			d := int(rgb[j]) - int(candidate[j])
+			distance += d * d
+		}
+
+		if bestDistance < 0 || distance < bestDistance {
+			best, bestDistance = index, distance
+		}
+	}
+
+	return best
+}
+
+// colorIndexRGB converts a 256-colors terminal palette index into RGB components.
+func colorIndexRGB(index uint8) [3]uint8 {
+	switch {
+	case index < 16:
+		return baseColors[index]
+	case index < 232:
+		// 6x6x6 color cube
+		cube := index - 16
+
+		return [3]uint8{cubeLevels[cube/36], cubeLevels[(cube/6)%6], cubeLevels[cube%6]}
+	default:
+		// grayscale ramp
+		gray := 8 + (index-232)*10
+
+		return [3]uint8{gray, gray, gray}
+	}
+}
@@ -0,0 +1,140 @@
+package tablewriter
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/logrusorgru/aurora/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestColorProfile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should strip escape sequences and render hyperlinks as text without colors", func(t *testing.T) {
+		table, buf := NewBuffered(
+			WithHeader([]string{"Name", "Link"}),
+			WithHeaderFormatters(map[int]Formatter{0: aurora.Red}),
+			WithColorProfile(ColorProfileNone),
+		)
+		table.AppendRich([]Cell{{Value: "\x1b[1mapi\x1b[0m"}, Hyperlink("docs", "https://x.io")})
+		require.NoError(t, table.Render())
+
+		const want = `+------+---------------------+
+| NAME |        LINK         |
++------+---------------------+
+| api  | docs (https://x.io) |
++------+---------------------+
+`
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should downgrade colors to 16 colors", func(t *testing.T) {
+		table, buf := NewBuffered(
+			WithHeader([]string{"Value"}),
+			WithRows([][]string{{"1"}, {"3"}}),
+			WithRules(Heatmap(0)),
+			WithColorProfile(ColorProfile16),
+		)
+		require.NoError(t, table.Render())
+
+		const want = "+-------+\n" +
+			"| VALUE |\n" +
+			"+-------+\n" +
+			"| \x1b[30;102m    1\x1b[0m |\n" +
+			"| \x1b[30;101m    3\x1b[0m |\n" +
+			"+-------+\n"
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should convert SGR parameters", func(t *testing.T) {
+		require.Equal(t, "1;31", ColorProfile16.convertParams("1;31"))
+		require.Equal(t, "38;5;196", ColorProfile256.convertParams("38;5;196"))
+		require.Equal(t, "91", ColorProfile16.convertParams("38;5;196"))
+		require.Equal(t, "44", ColorProfile16.convertParams("48;5;4"))
+		require.Equal(t, "1;38;5;196;4", ColorProfile256.convertParams("1;38;2;255;0;0;4"))
+		require.Equal(t, "97", ColorProfile16.convertParams("38;2;250;250;250"))
+		require.Equal(t, "38;5", ColorProfile16.convertParams("38;5"))
+	})
+}
+
+func TestDetectColorProfile(t *testing.T) {
+	// environment variables are altered: this test may not run in parallel
+	file, err := os.Create(filepath.Join(t.TempDir(), "out.txt"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = file.Close() })
+
+	unset := func(t *testing.T, keys ...string) {
+		for _, key := range keys {
+			t.Setenv(key, "")
+			require.NoError(t, os.Unsetenv(key))
+		}
+	}
+
+	t.Run("should disable colors when writing to a regular file", func(t *testing.T) {
+		unset(t, "NO_COLOR", "FORCE_COLOR")
+
+		require.Equal(t, ColorProfileNone, detectColorProfile(file))
+	})
+
+	t.Run("should leave other writers unaltered", func(t *testing.T) {
+		unset(t, "NO_COLOR", "FORCE_COLOR")
+
+		_, buf := NewBuffered()
+		require.Equal(t, ColorProfileTrueColor, detectColorProfile(buf))
+	})
+
+	t.Run("should honor the environment with other writers", func(t *testing.T) {
+		_, buf := NewBuffered()
+
+		t.Setenv("NO_COLOR", "1")
+		require.Equal(t, ColorProfileNone, detectColorProfile(buf))
+
+		t.Setenv("FORCE_COLOR", "2")
+		require.Equal(t, ColorProfile256, detectColorProfile(buf))
+
+		t.Setenv("FORCE_COLOR", "0")
+		require.Equal(t, ColorProfileNone, detectColorProfile(buf))
+	})
+
+	t.Run("should honor NO_COLOR", func(t *testing.T) {
+		unset(t, "FORCE_COLOR")
+		t.Setenv("NO_COLOR", "1")
+
+		table, _ := NewBuffered()
+		require.Equal(t, ColorProfileNone, table.colorProfile)
+		require.True(t, table.noHyperlinks)
+
+		table, _ = NewBuffered(WithColorProfile(ColorProfile256))
+		require.Equal(t, ColorProfile256, table.colorProfile)
+	})
+
+	t.Run("should honor FORCE_COLOR", func(t *testing.T) {
+		t.Setenv("NO_COLOR", "1")
+		t.Setenv("TERM", "xterm")
+		unset(t, "COLORTERM")
+
+		t.Setenv("FORCE_COLOR", "1")
+		require.Equal(t, ColorProfile16, detectColorProfile(file))
+
+		t.Setenv("FORCE_COLOR", "2")
+		require.Equal(t, ColorProfile256, detectColorProfile(file))
+
+		t.Setenv("FORCE_COLOR", "0")
+		require.Equal(t, ColorProfileNone, detectColorProfile(file))
+	})
+
+	t.Run("should infer the profile of a terminal", func(t *testing.T) {
+		unset(t, "COLORTERM")
+
+		t.Setenv("TERM", "xterm-256color")
+		require.Equal(t, ColorProfile256, terminalColorProfile())
+
+		t.Setenv("TERM", "dumb")
+		require.Equal(t, ColorProfileNone, terminalColorProfile())
+
+		t.Setenv("COLORTERM", "truecolor")
+		require.Equal(t, ColorProfileTrueColor, terminalColorProfile())
+	})
+}
@@ -8,38 +8,312 @@
 package tablewriter
 
 import (
+	"bufio"
+	"bytes"
 	"encoding/csv"
 	"errors"
+	"fmt"
 	"io"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
-// NewCSV builds a Table writer that reads its rows from a csv.Reader.
-func NewCSV(csvReader *csv.Reader, hasHeader bool, opts ...Option) (*Table, error) {
-	options := opts
+const csvSniffSize = 4096
+
+var (
+	csvBOM                 = []byte{0xEF, 0xBB, 0xBF}
+	csvDelimiterCandidates = []rune{',', ';', '\t', '|'}
+)
+
+// NewCSV builds a Table writer that renders to writer and reads its rows from a CSV file.
+//
+// See NewCSVFromReader for the options supported to read the CSV content.
+func NewCSV(writer io.Writer, fileName string, hasHeader bool, opts ...Option) (*Table, error) {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	return NewCSVFromReader(file, hasHeader, append([]Option{WithWriter(writer)}, opts...)...)
+}
+
+// NewCSVFromReader builds a Table writer that streams its rows from CSV content.
+//
+// A leading UTF-8 byte order mark is stripped.
+// Unless specified with WithCSVDelimiter, the delimiter is detected among ',', ';', '\t' and '|'.
+//
+// The following options determine how the CSV content is read:
+// WithCSVDelimiter, WithCSVComment, WithCSVHeaderRow, WithCSVColumns, WithCSVLimit.
+func NewCSVFromReader(reader io.Reader, hasHeader bool, opts ...Option) (*Table, error) {
+	buffered := bufio.NewReaderSize(reader, csvSniffSize)
+
+	if prefix, _ := buffered.Peek(len(csvBOM)); bytes.Equal(prefix, csvBOM) {
+		_, _ = buffered.Discard(len(csvBOM))
+	}
+
+	o := defaultOptions(opts)
+	csvReader := csv.NewReader(buffered)
+	csvReader.Comma = o.csvDelimiter
+	csvReader.Comment = o.csvComment
+
+	if csvReader.Comma == 0 {
+		sample, _ := buffered.Peek(csvSniffSize)
+		csvReader.Comma = detectCSVDelimiter(sample, o.csvComment, o.csvHeaderRow)
+	}
+
+	return NewCSVFromCSVReader(csvReader, hasHeader, opts...)
+}
+
+// NewCSVFromCSVReader builds a Table writer that reads its rows from a csv.Reader.
+//
+// Records are allowed to have a variable number of fields.
+//
+// The following options determine how the CSV content is read:
+// WithCSVHeaderRow, WithCSVColumns, WithCSVLimit.
+func NewCSVFromCSVReader(reader *csv.Reader, hasHeader bool, opts ...Option) (*Table, error) {
+	table := New(opts...)
+	if !hasHeader && len(table.csvColumns) > 0 {
+		return nil, errors.New("csv: columns may only be selected by name with a header")
+	}
+
+	reader.FieldsPerRecord = -1
+	csvReader := &csvRecordReader{Reader: reader}
+
+	for skip := 0; skip < table.csvHeaderRow; skip++ {
+		if _, err := csvReader.Read(); err != nil {
+			return nil, csvError(csvReader, "skipping to header row", err)
+		}
+	}
+
+	selected := func(record []string) []string { return record }
 
 	if hasHeader {
 		header, err := csvReader.Read()
 		if err != nil {
-			return nil, err
+			return nil, csvError(csvReader, "reading header", err)
 		}
 
-		options = append(options, WithHeader(header))
-	}
+		if len(table.csvColumns) > 0 {
+			indices, err := selectCSVColumns(header, table.csvColumns)
+			if err != nil {
+				return nil, fmt.Errorf("csv: line %d: %w", csvReader.line, err)
+			}
+
+			selected = func(record []string) []string {
+				projected := make([]string, len(indices))
+				for i, index := range indices {
+					if index < len(record) {
+						projected[i] = record[index]
+					}
+				}
+
+				return projected
+			}
+		}
 
-	table := New(options...)
+		table.header = selected(header)
+	}
 
-	for {
+	for count := 0; table.csvLimit <= 0 || count < table.csvLimit; count++ {
 		record, err := csvReader.Read()
 		if err != nil {
 			if errors.Is(err, io.EOF) {
 				break
 			}
 
-			return nil, err
+			return nil, csvError(csvReader, "reading record", err)
 		}
 
-		table.Append(record)
+		table.Append(selected(record))
 	}
 
 	return table, nil
 }
+
+// csvRecordReader keeps track of the line of the last record read.
+type csvRecordReader struct {
+	*csv.Reader
+	line int // line of the last record read
+	next int // line following the last record read, where the next record is read from
+}
+
+func (r *csvRecordReader) Read() ([]string, error) {
+	record, err := r.Reader.Read()
+	if err == nil {
+		r.line, _ = r.Reader.FieldPos(0)
+
+		// the last field may span several lines
+		last := len(record) - 1
+		end, _ := r.Reader.FieldPos(last)
+		r.next = end + strings.Count(record[last], "\n") + 1
+	}
+
+	return record, err
+}
+
+// csvError wraps errors when reading CSV with the line number of the record being read.
+//
+// Parsing errors from encoding/csv already report line numbers.
+func csvError(csvReader *csvRecordReader, action string, err error) error {
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return err
+	}
+
+	if errors.Is(err, io.EOF) {
+		err = io.ErrUnexpectedEOF
+	}
+
+	return fmt.Errorf("csv: line %d: %s: %w", max(csvReader.next, 1), action, err)
+}
+
+// selectCSVColumns yields the indices of the selected columns in the header.
+func selectCSVColumns(header, columns []string) ([]int, error) {
+	positions := make(map[string]int, len(header))
+	for i, name := range header {
+		if _, found := positions[strings.TrimSpace(name)]; !found {
+			positions[strings.TrimSpace(name)] = i
+		}
+	}
+
+	indices := make([]int, 0, len(columns))
+	for _, name := range columns {
+		index, ok := positions[strings.TrimSpace(name)]
+		if !ok {
+			return nil, fmt.Errorf("column %q not found in header", name)
+		}
+
+		indices = append(indices, index)
+	}
+
+	return indices, nil
+}
+
+// detectCSVDelimiter guesses the delimiter of some CSV content from a sample of its first lines.
+//
+// The selected delimiter appears the same number of times on every line of the sample,
+// with the highest count. The default is ','.
+//
+// Comment lines and the first skipped lines (e.g. a preamble before the header) are ignored.
+func detectCSVDelimiter(sample []byte, comment rune, skipped int) rune {
+	var lines []string
+	for _, line := range strings.Split(string(sample), "\n") {
+		line = strings.TrimRight(line, "\r")
+		if len(line) == 0 || (comment != 0 && strings.HasPrefix(line, string(comment))) {
+			continue
+		}
+
+		lines = append(lines, line)
+	}
+
+	if len(lines) > 1 {
+		lines = lines[:len(lines)-1] // the last line may be truncated
+	}
+
+	if skipped < len(lines) {
+		lines = lines[skipped:]
+	}
+
+	best, bestCount := ',', 0
+	for _, candidate := range csvDelimiterCandidates {
+		count, consistent := -1, true
+
+		for _, line := range lines {
+			n := countUnquoted(line, candidate)
+			if count >= 0 && n != count {
+				consistent = false
+
+				break
+			}
+
+			count = n
+		}
+
+		if consistent && count > bestCount {
+			best, bestCount = candidate, count
+		}
+	}
+
+	return best
+}
+
+// countUnquoted counts the occurrences of a rune outside of quoted sections.
+func countUnquoted(line string, r rune) int {
+	var (
+		count  int
+		quoted bool
+	)
+
+	for _, c := range line {
+		switch {
+		case c == '"':
+			quoted = !quoted
+		case c == r && !quoted:
+			count++
+		}
+	}
+
+	return count
+}
+
+// renderCSV renders the header and rows of the table as delimiter-separated values.
+//
+// Values are rendered as is, without titling the header. The rows of the footer are rendered only if WithCSVFooter is enabled.
+func (t *Table) renderCSV(delimiter rune) {
+	if delimiter == 0 {
+		delimiter = ','
+	}
+
+	newLine := stringIf(t.csvUseCRLF, "\r\n", "\n")
+
+	writeRecord := func(record []string) {
+		for col := 0; col < t.numColumns; col++ {
+			if col > 0 {
+				fmt.Fprint(t.out, string(delimiter))
+			}
+
+			var field string
+			if col < len(record) {
+				field = record[col]
+			}
+
+			fmt.Fprint(t.out, t.csvField(field, delimiter))
+		}
+
+		fmt.Fprint(t.out, newLine)
+	}
+
+	if len(t.headerValues) > 0 {
+		writeRecord(t.headerValues)
+	}
+
+	for _, row := range t.rowValues {
+		writeRecord(row)
+	}
+
+	if t.csvWithFooter {
+		for _, footer := range t.footerValues {
+			writeRecord(footer)
+		}
+	}
+}
+
+// csvField quotes a field whenever needed, with the same rules as encoding/csv.
+func (t *Table) csvField(field string, delimiter rune) string {
+	first, _ := utf8.DecodeRuneInString(field)
+	needsQuotes := t.csvQuoteAll ||
+		strings.ContainsRune(field, delimiter) ||
+		strings.ContainsAny(field, "\"\r\n") ||
+		unicode.IsSpace(first)
+
+	if !needsQuotes {
+		return field
+	}
+
+	return `"` + strings.ReplaceAll(field, `"`, `""`) + `"`
+}
@@ -0,0 +1,92 @@
+package tablewriter
+
+type csvOptions struct {
+	csvDelimiter  rune
+	csvQuoteAll   bool
+	csvUseCRLF    bool
+	csvWithFooter bool
+
+	// CSV input
+	csvComment   rune
+	csvHeaderRow int
+	csvColumns   []string
+	csvLimit     int
+}
+
+func defaultCSVOptions() csvOptions {
+	return csvOptions{}
+}
+
+// WithCSVDelimiter defines the field delimiter used to read CSV content and to render FormatCSV.
+//
+// When reading CSV content, the delimiter is detected by default.
+// When rendering FormatCSV, the default is ','. FormatTSV always uses a tab.
+func WithCSVDelimiter(delimiter rune) Option {
+	return func(o *options) {
+		o.csvDelimiter = delimiter
+	}
+}
+
+// WithCSVQuoteAll quotes all fields rendered by FormatCSV or FormatTSV.
+//
+// By default, fields are quoted only when needed.
+func WithCSVQuoteAll(enabled bool) Option {
+	return func(o *options) {
+		o.csvQuoteAll = enabled
+	}
+}
+
+// WithCSVCRLF terminates records rendered by FormatCSV or FormatTSV with \r\n.
+//
+// By default, records are terminated by \n.
+func WithCSVCRLF(enabled bool) Option {
+	return func(o *options) {
+		o.csvUseCRLF = enabled
+	}
+}
+
+// WithCSVFooter includes the rows of the footer as last records rendered by FormatCSV or FormatTSV.
+//
+// By default, the footer is not rendered.
+func WithCSVFooter(enabled bool) Option {
+	return func(o *options) {
+		o.csvWithFooter = enabled
+	}
+}
+
+// WithCSVComment defines the character that starts comment lines when reading CSV content.
+//
+// By default, there is no comment line.
+func WithCSVComment(comment rune) Option {
+	return func(o *options) {
+		o.csvComment = comment
+	}
+}
+
+// WithCSVHeaderRow defines the index of the record holding the header when reading CSV content.
+//
+// Preceding records are skipped. The default is 0.
+func WithCSVHeaderRow(row int) Option {
+	return func(o *options) {
+		o.csvHeaderRow = row
+	}
+}
+
+// WithCSVColumns selects and orders the columns to retain by their name in the header, when reading CSV content.
+//
+// This option requires a header: reading CSV content without a header fails when columns are selected.
+// By default, all columns are retained.
+func WithCSVColumns(names ...string) Option {
+	return func(o *options) {
+		o.csvColumns = names
+	}
+}
+
+// WithCSVLimit defines the maximum number of rows to read from CSV content.
+//
+// By default, all rows are read.
+func WithCSVLimit(limit int) Option {
+	return func(o *options) {
+		o.csvLimit = limit
+	}
+}
@@ -3,20 +3,20 @@ package tablewriter
 import (
 	"bytes"
 	"encoding/csv"
+	"errors"
+	"io"
 	"os"
+	"strings"
 	"testing"
+	"testing/iotest"
 
 	"github.com/stretchr/testify/require"
 )
 
 func TestCSVInfo(t *testing.T) {
-	file, err := os.Open("testdata/test_info.csv")
-	require.NoError(t, err)
-
-	reader := csv.NewReader(file)
 	var buf bytes.Buffer
 
-	table, err := NewCSV(reader, true,
+	table, err := NewCSV(&buf, "testdata/test_info.csv", true,
 		WithCellAlignment(AlignLeft),
 		WithAllBorders(false),
 		WithWriter(&buf),
@@ -44,7 +44,7 @@ func TestCSVSeparator(t *testing.T) {
 	reader := csv.NewReader(file)
 	var buf bytes.Buffer
 
-	table, err := NewCSV(reader, true,
+	table, err := NewCSVFromCSVReader(reader, true,
 		WithRowLine(true),
 		WithCenterSeparator("+"),
 		WithColumnSeparator("|"),
@@ -68,3 +68,149 @@ func TestCSVSeparator(t *testing.T) {
 
 	checkEqual(t, buf.String(), want, "CSV info failed")
 }
+
+func TestRenderCSV(t *testing.T) {
+	t.Parallel()
+
+	options := []Option{
+		WithHeader([]string{"First Name", "Last Name", "SSN"}),
+		WithRows([][]string{
+			{"John", "Barry", "123456"},
+			{"Kathy", `Smith, "K"`, "687987"},
+			{"Bob", " McCornick\nJr", "3979870"},
+		}),
+		WithFooter([]string{"", "Total", "3"}),
+	}
+
+	t.Run("should render CSV", func(t *testing.T) {
+		table, buf := NewBuffered(append(options, WithFormat(FormatCSV))...)
+		require.NoError(t, table.Render())
+
+		const want = `First Name,Last Name,SSN
+John,Barry,123456
+Kathy,"Smith, ""K""",687987
+Bob," McCornick
+Jr",3979870
+`
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should render CSV with options", func(t *testing.T) {
+		table, buf := NewBuffered(append(options,
+			WithFormat(FormatCSV),
+			WithCSVDelimiter(';'),
+			WithCSVQuoteAll(true),
+			WithCSVCRLF(true),
+			WithCSVFooter(true),
+		)...)
+		require.NoError(t, table.Render())
+
+		const want = "\"First Name\";\"Last Name\";\"SSN\"\r\n" +
+			"\"John\";\"Barry\";\"123456\"\r\n" +
+			"\"Kathy\";\"Smith, \"\"K\"\"\";\"687987\"\r\n" +
+			"\"Bob\";\" McCornick\nJr\";\"3979870\"\r\n" +
+			"\"\";\"Total\";\"3\"\r\n"
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should render TSV that reads back the same", func(t *testing.T) {
+		table, buf := NewBuffered(append(options, WithFormat(FormatTSV))...)
+		require.NoError(t, table.Render())
+
+		reader := csv.NewReader(bytes.NewReader(buf.Bytes()))
+		reader.Comma = '\t'
+		records, err := reader.ReadAll()
+		require.NoError(t, err)
+
+		require.Equal(t, append([][]string{table.Header()}, table.Rows()...), records)
+	})
+}
+
+func TestCSVFromReader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should detect delimiters", func(t *testing.T) {
+		for _, delimiter := range []string{",", ";", "\t", "|"} {
+			content := strings.Join([]string{
+				strings.Join([]string{"name", "sign", "rating"}, delimiter),
+				strings.Join([]string{"A", `"The Good, the Bad; the | Ugly"`, "500"}, delimiter),
+				strings.Join([]string{"B", "The Ugly", "120"}, delimiter),
+			}, "\n")
+
+			table, err := NewCSVFromReader(strings.NewReader(content), true)
+			require.NoError(t, err)
+
+			require.Equal(t, []string{"name", "sign", "rating"}, table.Header())
+			require.Equal(t, [][]string{
+				{"A", "The Good, the Bad; the | Ugly", "500"},
+				{"B", "The Ugly", "120"},
+			}, table.Rows())
+		}
+	})
+
+	t.Run("should strip BOM, skip comments and select the header row", func(t *testing.T) {
+		const content = "\xEF\xBB\xBF# exported data\n" +
+			"report;2014\n" +
+			"name;sign;rating\n" +
+			"# comment\n" +
+			"A;The Good;500\n"
+
+		table, err := NewCSVFromReader(strings.NewReader(content), true,
+			WithCSVComment('#'),
+			WithCSVHeaderRow(1),
+		)
+		require.NoError(t, err)
+
+		require.Equal(t, []string{"name", "sign", "rating"}, table.Header())
+		require.Equal(t, [][]string{{"A", "The Good", "500"}}, table.Rows())
+	})
+
+	t.Run("should select columns and limit rows", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		table, err := NewCSV(&buf, "testdata/test_info.csv", true,
+			WithCSVColumns("Key", "Field"),
+			WithCSVLimit(2),
+		)
+		require.NoError(t, err)
+		require.NoError(t, table.Render())
+
+		const want = `+-----+----------+
+| KEY |  FIELD   |
++-----+----------+
+| PRI | user_id  |
+|     | username |
++-----+----------+
+`
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should report errors with line numbers", func(t *testing.T) {
+		_, err := NewCSVFromReader(strings.NewReader("a,b\nc,\"d\n"), true)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "line 2")
+
+		_, err = NewCSVFromReader(strings.NewReader("a,b\nc,d\n"), true, WithCSVColumns("e"))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "line 1")
+
+		_, err = NewCSVFromReader(strings.NewReader("a,b\nc,d\n"), false, WithCSVColumns("a"))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "header")
+
+		_, err = NewCSVFromReader(strings.NewReader("a,b\n"), true, WithCSVHeaderRow(2))
+		require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+		require.Contains(t, err.Error(), "line 2")
+
+		errRead := errors.New("read failed")
+		content := io.MultiReader(strings.NewReader("a,b\nc,\"d\ne\"\n"), iotest.ErrReader(errRead))
+		_, err = NewCSVFromCSVReader(csv.NewReader(content), true)
+		require.ErrorIs(t, err, errRead)
+		require.Contains(t, err.Error(), "line 4")
+	})
+
+	t.Run("should report missing file", func(t *testing.T) {
+		_, err := NewCSV(io.Discard, "testdata/missing.csv", true)
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
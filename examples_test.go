@@ -1,7 +1,6 @@
 package tablewriter_test
 
 import (
-	"encoding/csv"
 	"fmt"
 	"log"
 	"os"
@@ -154,14 +153,7 @@ func ExampleNewBuffered() {
 }
 
 func ExampleNewCSV() {
-	file, err := os.Open("testdata/test.csv")
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	reader := csv.NewReader(file)
-
-	table, err := tablewriter.NewCSV(reader, true,
+	table, err := tablewriter.NewCSV(os.Stdout, "testdata/test.csv", true,
 		tablewriter.WithCenterSeparator("*"),
 		tablewriter.WithRowSeparator("="),
 	)
@@ -388,6 +380,8 @@ func ExampleFormatter() {
 			1: aurora.Blue,
 			2: aurora.Bold,
 		}),
+		// colors are disabled when the output is not a terminal: force colors for this example
+		tablewriter.WithColorProfile(tablewriter.ColorProfileTrueColor),
 	)
 
 	table.Render()
@@ -416,6 +410,7 @@ func ExampleWithRowSeparator() {
 		tablewriter.WithRowSeparator(red(tablewriter.ROW)),
 		tablewriter.WithColumnSeparator(red(tablewriter.COLUMN)),
 		tablewriter.WithCenterSeparator(red(tablewriter.CENTER)),
+		tablewriter.WithColorProfile(tablewriter.ColorProfileTrueColor),
 	)
 
 	table.Render()
@@ -454,3 +449,25 @@ func ExampleWithMaxTableWidth() {
 	// | D    | The Gopher |    800 |
 	// +------+------------+--------+
 }
+
+func ExampleWithStyle() {
+	data := sampleData()
+
+	// draws the grid with unicode box-drawing characters
+	table := tablewriter.New(
+		tablewriter.WithHeader([]string{"Name", "Sign", "Rating"}),
+		tablewriter.WithRows(data),
+		tablewriter.WithStyle(tablewriter.StyleRounded),
+	)
+	table.Render()
+
+	// Output:
+	// ╭──────┬───────────────────────┬────────╮
+	// │ NAME │         SIGN          │ RATING │
+	// ├──────┼───────────────────────┼────────┤
+	// │ A    │ The Good              │    500 │
+	// │ B    │ The Very very Bad Man │    288 │
+	// │ C    │ The Ugly              │    120 │
+	// │ D    │ The Gopher            │    800 │
+	// ╰──────┴───────────────────────┴────────╯
+}
@@ -9,6 +9,21 @@ type formatOptions struct {
 	columnsParams map[int]Formatter
 	footerParams  map[int]Formatter
 	captionParams Formatter
+	rules         []Rule
+
+	// zebra striping
+	stripeFormatter Formatter
+	stripeEvery     int
+
+	// label rows of groups
+	groupParams Formatter
+
+	// borders
+	borderParams          Formatter
+	headerSeparatorParams Formatter
+	frameParams           Formatter
+
+	noHyperlinks bool
 }
 
 func defaultFormatOptions() formatOptions {
@@ -19,12 +34,41 @@ func defaultFormatOptions() formatOptions {
 	}
 }
 
+// chainFormatters combines several formatters, applied in order. Nil formatters are ignored.
+//
+// aurora formatters combine with values already formatted: e.g. a foreground color may be
+// combined with a background color.
+func chainFormatters(formatters ...Formatter) Formatter {
+	chain := make([]Formatter, 0, len(formatters))
+	for _, formatter := range formatters {
+		if formatter != nil {
+			chain = append(chain, formatter)
+		}
+	}
+
+	switch len(chain) {
+	case 0:
+		return nil
+	case 1:
+		return chain[0]
+	default:
+		return func(in interface{}) aurora.Value {
+			value := chain[0](in)
+			for _, formatter := range chain[1:] {
+				value = formatter(value)
+			}
+
+			return value
+		}
+	}
+}
+
 func format(in string, formatter Formatter) string {
 	if formatter == nil {
 		return in
 	}
 
-	return aurora.Sprintf(formatter(in))
+	return formatter(in).String()
 }
 
 // WithHeaderFormatters allows to specify ANSI terminal control sequences to format the header.
@@ -58,3 +102,63 @@ func WithCaptionFormatter(formatter Formatter) Option {
 		o.captionParams = formatter
 	}
 }
+
+// WithZebraStripes formats every other band of rows, e.g. with a background color, to improve the readability
+// of large tables.
+//
+// Bands are made of "every" rows. The default is 1, i.e. every other row is formatted.
+// The formatting covers the padding of cells, but not the column separators.
+// Merged cells (see WithMergeCells) keep the band of the row where they start.
+// With groups of rows (see WithGroupBy), bands restart with each group.
+func WithZebraStripes(formatter Formatter, every int) Option {
+	return func(o *options) {
+		o.stripeFormatter = formatter
+		o.stripeEvery = every
+	}
+}
+
+// WithGroupFormatter allows to specify ANSI terminal control sequences to format the label rows of groups
+// (see WithGroupBy).
+func WithGroupFormatter(formatter Formatter) Option {
+	return func(o *options) {
+		o.groupParams = formatter
+	}
+}
+
+// WithBorderFormatter allows to specify ANSI terminal control sequences to format the lines of the grid,
+// e.g. to dim the grid so that the content stands out.
+//
+// Unless specified otherwise with WithHeaderSeparatorFormatter or WithFrameFormatter, this applies to
+// the separator below the header and to the outer frame of the table as well.
+func WithBorderFormatter(formatter Formatter) Option {
+	return func(o *options) {
+		o.borderParams = formatter
+	}
+}
+
+// WithHeaderSeparatorFormatter allows to specify ANSI terminal control sequences to format the line below the header.
+func WithHeaderSeparatorFormatter(formatter Formatter) Option {
+	return func(o *options) {
+		o.headerSeparatorParams = formatter
+	}
+}
+
+// WithFrameFormatter allows to specify ANSI terminal control sequences to format the outer frame of the table,
+// i.e. the top and bottom lines and the left and right borders.
+func WithFrameFormatter(formatter Formatter) Option {
+	return func(o *options) {
+		o.frameParams = formatter
+	}
+}
+
+// WithHyperlinks enables or disables hyperlinks in cells (see Hyperlink). Hyperlinks are enabled by default.
+//
+// On terminals, hyperlinks are rendered with OSC 8 escape sequences, which are ignored by terminals
+// that don't support them. When disabled, hyperlinks are rendered as plain text, like "label (target)".
+//
+// This only applies to text tables: HTML and markdown always render links, and other formats render labels only.
+func WithHyperlinks(enabled bool) Option {
+	return func(o *options) {
+		o.noHyperlinks = !enabled
+	}
+}
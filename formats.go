@@ -0,0 +1,81 @@
+package tablewriter
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Format is the output format of a rendered table.
+type Format uint8
+
+// Output formats
+const (
+	// FormatText renders the table as text, for display on a terminal. This is the default.
+	FormatText Format = iota
+
+	// FormatMarkdown renders the table as a GitHub-flavored markdown table.
+	FormatMarkdown
+
+	// FormatHTML renders the table as an HTML table.
+	FormatHTML
+
+	// FormatJSON renders the rows of the table as a JSON array of objects keyed by header.
+	FormatJSON
+
+	// FormatNDJSON renders the rows of the table as newline-delimited JSON objects keyed by header.
+	FormatNDJSON
+
+	// FormatYAML renders the rows of the table as a YAML sequence of mappings keyed by header.
+	FormatYAML
+
+	// FormatCSV renders the header and rows of the table as comma-separated values.
+	FormatCSV
+
+	// FormatTSV renders the header and rows of the table as tab-separated values.
+	FormatTSV
+)
+
+var formatNames = map[Format]string{
+	FormatText:     "text",
+	FormatMarkdown: "markdown",
+	FormatHTML:     "html",
+	FormatJSON:     "json",
+	FormatNDJSON:   "ndjson",
+	FormatYAML:     "yaml",
+	FormatCSV:      "csv",
+	FormatTSV:      "tsv",
+}
+
+// ParseFormat parses the name of an output format, e.g. from a command line flag.
+//
+// Names are case-insensitive: "text", "markdown", "html", "json", "ndjson", "yaml", "csv", "tsv".
+func ParseFormat(name string) (Format, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+
+	for format, formatName := range formatNames {
+		if name == formatName {
+			return format, nil
+		}
+	}
+
+	return FormatText, fmt.Errorf("unknown table format: %q", name)
+}
+
+// String representation of a Format.
+func (f Format) String() string {
+	name, ok := formatNames[f]
+	if !ok {
+		return fmt.Sprintf("Format(%d)", f)
+	}
+
+	return name
+}
+
+// WithFormat defines the output format of the table.
+//
+// The default is FormatText.
+func WithFormat(format Format) Option {
+	return func(o *options) {
+		o.format = format
+	}
+}
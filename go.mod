@@ -9,10 +9,10 @@ require (
 	github.com/sergi/go-diff v1.2.0
 	github.com/stretchr/testify v1.8.1
 	golang.org/x/text v0.5.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	github.com/rivo/uniseg v0.4.3 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -0,0 +1,42 @@
+package tablewriter
+
+type groupOptions struct {
+	groupBy        []int
+	groupSubtotals []FooterRow
+	groupSeparator bool
+}
+
+func defaultGroupOptions() groupOptions {
+	return groupOptions{}
+}
+
+// WithGroupBy groups the rows by the values of some key columns.
+//
+// Each group is rendered under a label row spanning all columns, with the values of the key columns of the group.
+// Groups are rendered in the order of their first row, and rows retain their order within a group.
+//
+// Grouping applies to text tables only. Groups are not meant to be combined with cells spanning several rows, nor
+// with WithMergeCells.
+func WithGroupBy(cols ...int) Option {
+	return func(o *options) {
+		o.groupBy = cols
+	}
+}
+
+// WithGroupSubtotals appends rows computed from the rows of each group, e.g. subtotals, at the end of the group.
+//
+// Subtotals are formatted and aligned like the rows of the table.
+func WithGroupSubtotals(rows ...FooterRow) Option {
+	return func(o *options) {
+		o.groupSubtotals = append(o.groupSubtotals, rows...)
+	}
+}
+
+// WithGroupSeparator prints a separation line between groups of rows.
+//
+// By default, groups are not separated by a line, unless WithRowLine is enabled.
+func WithGroupSeparator(enabled bool) Option {
+	return func(o *options) {
+		o.groupSeparator = enabled
+	}
+}
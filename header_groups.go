@@ -0,0 +1,113 @@
+package tablewriter
+
+import (
+	wrap "github.com/fredbi/tablewriter/tablewrappers"
+)
+
+// headerGroupRowIdx yields the special row index of a level of groups above the header.
+//
+// Levels are numbered from the top.
+func headerGroupRowIdx(level int) int {
+	return noRowIdx - 1 - level
+}
+
+// headerGroupWidth yields the number of columns spanned by a level of groups.
+func headerGroupWidth(groups []Cell) int {
+	width := 0
+	for _, group := range groups {
+		width += max(group.ColSpan, 1)
+	}
+
+	return width
+}
+
+// prepareHeaderGroups lays out the groups of columns above the header.
+//
+// Groups spanning several columns are registered as spans, so junctions are omitted inside groups.
+func (t *Table) prepareHeaderGroups() {
+	t.groups = nil
+	t.groupLines = nil
+
+	if len(t.header) == 0 {
+		return
+	}
+
+	var spans []cellSpan
+
+	for level, groups := range t.headerGroups {
+		row := headerGroupRowIdx(level)
+		cells := make([]Cell, t.numColumns)
+		lines := make([][]string, t.numColumns)
+		t.setRowHeight(row, 1)
+
+		col := 0
+		for _, group := range groups {
+			cols := min(max(group.ColSpan, 1), t.numColumns-col)
+			cells[col] = group
+			lines[col] = wrap.PreserveEscapes(splitLines(group.Value))
+			t.setRowHeight(row, len(lines[col]))
+
+			if cols > 1 {
+				spans = append(spans, cellSpan{cellPos: cellPos{row: row, col: col}, rows: 1, cols: cols})
+			} else {
+				// widths of groups spanning a single column are settled first
+				t.setColWidth(col, wrap.CellWidth(lines[col]))
+			}
+
+			col += cols
+		}
+
+		t.groups = append(t.groups, cells)
+		t.groupLines = append(t.groupLines, lines)
+	}
+
+	if len(spans) == 0 {
+		return
+	}
+
+	if t.spanOrigins == nil {
+		t.spanOrigins = make(map[cellPos]cellSpan)
+	}
+
+	// groups spanning several columns are spread over the widths settled by all levels
+	for _, span := range spans {
+		level := headerGroupRowIdx(0) - span.row
+		t.spreadWidth(span.col, span.cols, wrap.CellWidth(t.groupLines[level][span.col]))
+
+		for col := span.col; col < span.col+span.cols; col++ {
+			t.spanOrigins[cellPos{row: span.row, col: col}] = span
+		}
+	}
+}
+
+// groupAlignment yields the alignment of a group of columns.
+func (t *Table) groupAlignment(level, col int) HAlignment {
+	_, col = t.spanOrigin(headerGroupRowIdx(level), col)
+	if align := t.groups[level][col].Align; align != AlignDefault {
+		return align
+	}
+
+	return t.headerAlign
+}
+
+// groupFormatter yields the formatter of a group of columns, if any.
+func (t *Table) groupFormatter(level, col int) Formatter {
+	_, col = t.spanOrigin(headerGroupRowIdx(level), col)
+	if formatter := t.groups[level][col].Formatter; formatter != nil {
+		return formatter
+	}
+
+	return t.headerParams[col]
+}
+
+// groupFormatters yields the formatters of all the groups of a level, for each column.
+func (t *Table) groupFormatters(level int) map[int]Formatter {
+	formatters := make(map[int]Formatter, t.numColumns)
+	for col := 0; col < t.numColumns; col++ {
+		if formatter := t.groupFormatter(level, col); formatter != nil {
+			formatters[col] = formatter
+		}
+	}
+
+	return formatters
+}
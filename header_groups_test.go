@@ -0,0 +1,98 @@
+package tablewriter
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/logrusorgru/aurora/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeaderGroups(t *testing.T) {
+	t.Parallel()
+
+	metrics := func(opts ...Option) (*Table, *bytes.Buffer) {
+		table, buf := NewBuffered(append([]Option{
+			WithHeader([]string{"Region", "p50", "p95", "p99", "errors"}),
+			WithHeaderGroups(
+				[]Cell{{}, {Value: "service_metrics", ColSpan: 4}},
+				[]Cell{{}, {Value: "latency (ms)", ColSpan: 3}, {Value: "rate"}},
+			),
+		}, opts...)...)
+		table.Append([]string{"eu", "12", "45", "120", "0.1%"})
+		table.Append([]string{"us", "10", "40", "99", "0.2%"})
+
+		return table, buf
+	}
+
+	t.Run("should render groups of columns above the header", func(t *testing.T) {
+		table, buf := metrics(WithStyle(StyleLight))
+		require.NoError(t, table.Render())
+
+		const want = `┌────────┬──────────────────────────┐
+│        │     SERVICE METRICS      │
+├────────┼─────────────────┬────────┤
+│        │  LATENCY (MS)   │  RATE  │
+├────────┼─────┬─────┬─────┼────────┤
+│ REGION │ P50 │ P95 │ P99 │ ERRORS │
+├────────┼─────┼─────┼─────┼────────┤
+│ eu     │  12 │  45 │ 120 │   0.1% │
+│ us     │  10 │  40 │  99 │   0.2% │
+└────────┴─────┴─────┴─────┴────────┘
+`
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should widen the columns under a wide group", func(t *testing.T) {
+		table, buf := NewBuffered(
+			WithHeader([]string{"a", "b"}),
+			WithHeaderGroups([]Cell{{Value: "a_long_group_label", ColSpan: 2, Align: AlignLeft}}),
+		)
+		table.Append([]string{"1", "2"})
+		require.NoError(t, table.Render())
+
+		const want = `+--------------------+
+| A LONG GROUP LABEL |
++----------+---------+
+|    A     |    B    |
++----------+---------+
+|        1 |       2 |
++----------+---------+
+`
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should format groups with the header formatters", func(t *testing.T) {
+		table, buf := NewBuffered(
+			WithHeader([]string{"a", "b"}),
+			WithHeaderGroups([]Cell{{Value: "x"}, {Value: "y", Formatter: aurora.Bold}}),
+			WithHeaderFormatters(map[int]Formatter{0: aurora.Red}),
+			WithHeaderLine(false),
+			WithBorders(Border{}),
+		)
+		require.NoError(t, table.Render())
+
+		const want = "  \x1b[31mX\x1b[0m | \x1b[1mY\x1b[0m\n" +
+			"  \x1b[31mA\x1b[0m | B\n"
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should render groups in HTML", func(t *testing.T) {
+		table, buf := metrics(WithFormat(FormatHTML))
+		require.NoError(t, table.Render())
+
+		const want = `<table>
+  <thead>
+    <tr><th style="text-align: center"></th><th colspan="4" style="text-align: center">SERVICE METRICS</th></tr>
+    <tr><th style="text-align: center"></th><th colspan="3" style="text-align: center">LATENCY (MS)</th><th style="text-align: center">RATE</th></tr>
+    <tr><th style="text-align: center">REGION</th><th style="text-align: center">P50</th><th style="text-align: center">P95</th><th style="text-align: center">P99</th><th style="text-align: center">ERRORS</th></tr>
+  </thead>
+  <tbody>
+    <tr><td>eu</td><td style="text-align: right">12</td><td style="text-align: right">45</td><td style="text-align: right">120</td><td style="text-align: right">0.1%</td></tr>
+    <tr><td>us</td><td style="text-align: right">10</td><td style="text-align: right">40</td><td style="text-align: right">99</td><td style="text-align: right">0.2%</td></tr>
+  </tbody>
+</table>
+`
+		checkEqual(t, buf.String(), want)
+	})
+}
@@ -1,12 +1,22 @@
 package tablewriter
 
 import (
+	"os"
 	"testing"
 
 	"github.com/sergi/go-diff/diffmatchpatch"
 	"github.com/stretchr/testify/assert"
 )
 
+func TestMain(m *testing.M) {
+	// escape sequences are rendered unaltered, whatever the environment running the tests
+	for _, key := range []string{"NO_COLOR", "FORCE_COLOR"} {
+		_ = os.Unsetenv(key)
+	}
+
+	os.Exit(m.Run())
+}
+
 func checkEqual(t *testing.T, got, want interface{}, msgs ...interface{}) {
 	t.Helper()
 
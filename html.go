@@ -0,0 +1,328 @@
+package tablewriter
+
+import (
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/logrusorgru/aurora/v4"
+)
+
+const (
+	htmlLineBreak = "<br>"
+	htmlIndent    = "  "
+)
+
+// htmlSpan is the number of rows and columns spanned by a cell.
+type htmlSpan struct {
+	rows, cols int
+}
+
+// renderHTML renders the table as an HTML table.
+//
+// Header, rows and footer are rendered in <thead>, <tbody> and <tfoot> sections, with an optional <caption>.
+// Content is HTML-escaped and multi-lines cells are rendered with <br> line breaks.
+//
+// Alignment and Formatters are rendered as inline CSS styles.
+// Merged cells (see WithMergeCells) are rendered as cells spanning several rows.
+func (t *Table) renderHTML() {
+	fmt.Fprint(t.out, "<table>", t.newLine)
+
+	if len(t.captionText) > 0 {
+		fmt.Fprint(t.out, htmlIndent, "<caption", htmlStyleAttr(t.captionParams, ""), ">",
+			html.EscapeString(t.captionText),
+			"</caption>", t.newLine,
+		)
+	}
+
+	titler := t.headerPrepadder()
+	headerAlign := func(row, col int, _ string) HAlignment {
+		if row < len(t.groups) {
+			return t.groupAlignment(row, col)
+		}
+
+		return t.headerAlign
+	}
+	footerAlign := func(_, _ int, _ string) HAlignment { return t.footerAlign }
+	cellAlign := func(row, col int, value string) HAlignment {
+		align := t.cellAlignment(row, col)
+		if align == AlignDefault && isNumerical(value) {
+			return AlignRight
+		}
+
+		return align
+	}
+	headerFormatter := func(row, col int) Formatter {
+		if row < len(t.groups) {
+			return t.groupFormatter(row, col)
+		}
+
+		return t.headerParams[col]
+	}
+	footerFormatter := func(_, col int) Formatter { return t.footerParams[col] }
+
+	if len(t.header) > 0 {
+		headers, spans := t.htmlHeaders()
+		t.printHTMLSection("thead", "th", headers, spans, titler, headerAlign, headerFormatter, nil)
+	}
+
+	t.printHTMLSection("tbody", "td", t.rowValues, t.htmlSpans(), identity, cellAlign, t.cellFormatter, t.cellLink)
+
+	if len(t.footerValues) > 0 {
+		t.printHTMLSection("tfoot", "td", t.footerValues, nil, titler, footerAlign, footerFormatter, nil)
+	}
+
+	fmt.Fprint(t.out, "</table>", t.newLine)
+}
+
+// printHTMLSection prints a section of the table (thead, tbody or tfoot).
+//
+// When specified, spans indicate the number of rows and columns spanned by each cell. Cells with a zero span are
+// not rendered.
+//
+// When specified, link yields the target of the hyperlink in a cell, if any.
+func (t *Table) printHTMLSection(
+	section, tag string,
+	rows [][]string,
+	spans [][]htmlSpan,
+	prepadder transformer,
+	aligner func(row, col int, value string) HAlignment,
+	formatter func(row, col int) Formatter,
+	link func(row, col int) string,
+) {
+	fmt.Fprint(t.out, htmlIndent, "<", section, ">", t.newLine)
+
+	for i, row := range rows {
+		fmt.Fprint(t.out, htmlIndent, htmlIndent, "<tr>")
+
+		for col := 0; col < t.numColumns; col++ {
+			var value string
+			if col < len(row) {
+				value = row[col]
+			}
+
+			var spanAttrs string
+			if spans != nil {
+				span := spans[i][col]
+				if span.rows == 0 || span.cols == 0 {
+					continue
+				}
+
+				if span.rows > 1 {
+					spanAttrs = fmt.Sprintf(` rowspan="%d"`, span.rows)
+				}
+
+				if span.cols > 1 {
+					spanAttrs += fmt.Sprintf(` colspan="%d"`, span.cols)
+				}
+			}
+
+			lines := splitLines(value)
+			for j, line := range lines {
+				lines[j] = html.EscapeString(prepadder(line))
+			}
+
+			content := strings.Join(lines, htmlLineBreak)
+			if link != nil {
+				if target := link(i, col); len(target) > 0 {
+					content = `<a href="` + html.EscapeString(target) + `">` + content + "</a>"
+				}
+			}
+
+			fmt.Fprint(t.out, "<", tag, spanAttrs, htmlStyleAttr(formatter(i, col), htmlTextAlign(aligner(i, col, value))), ">",
+				content,
+				"</", tag, ">",
+			)
+		}
+
+		fmt.Fprint(t.out, "</tr>", t.newLine)
+	}
+
+	fmt.Fprint(t.out, htmlIndent, "</", section, ">", t.newLine)
+}
+
+// htmlHeaders yields the rows of the header section: the groups of columns, if any, then the header.
+func (t *Table) htmlHeaders() ([][]string, [][]htmlSpan) {
+	if len(t.groups) == 0 {
+		return [][]string{t.headerValues}, nil
+	}
+
+	headers := make([][]string, 0, len(t.groups)+1)
+	spans := make([][]htmlSpan, 0, len(t.groups)+1)
+
+	for level, groups := range t.groups {
+		row := headerGroupRowIdx(level)
+		values := make([]string, t.numColumns)
+		levelSpans := make([]htmlSpan, t.numColumns)
+
+		for col, group := range groups {
+			values[col] = group.Value
+			if cols := t.colSpan(row, col); cols > 0 {
+				levelSpans[col] = htmlSpan{rows: 1, cols: cols}
+			}
+		}
+
+		headers = append(headers, values)
+		spans = append(spans, levelSpans)
+	}
+
+	headerSpans := make([]htmlSpan, t.numColumns)
+	for col := range headerSpans {
+		headerSpans[col] = htmlSpan{rows: 1, cols: 1}
+	}
+
+	return append(headers, t.headerValues), append(spans, headerSpans)
+}
+
+// htmlSpans determines how many rows and columns are spanned by each cell of the table, when cells
+// span several rows or columns, or when merging cells.
+//
+// Cells that are covered by a span or merged with the cell above get a zero span.
+func (t *Table) htmlSpans() [][]htmlSpan {
+	spans := make([][]htmlSpan, len(t.rows))
+	for i := range spans {
+		spans[i] = make([]htmlSpan, t.numColumns)
+		for col := range spans[i] {
+			span, isSpanned := t.spanAt(i, col)
+			switch {
+			case !isSpanned:
+				spans[i][col] = htmlSpan{rows: 1, cols: 1}
+			case span.row == i && span.col == col:
+				spans[i][col] = htmlSpan{rows: span.rows, cols: span.cols}
+			}
+		}
+	}
+
+	if !t.autoMergeCells || len(t.spans) > 0 {
+		return spans
+	}
+
+	for col := 0; col < t.numColumns; col++ {
+		if t.columnsToAutoMergeCells != nil && !t.columnsToAutoMergeCells[col] {
+			continue
+		}
+
+		start := 0
+		for i := 1; i < len(t.rows); i++ {
+			value := strings.TrimSpace(t.rowValues[i][col])
+			if len(value) > 0 && value == strings.TrimSpace(t.rowValues[start][col]) {
+				spans[start][col].rows++
+				spans[i][col] = htmlSpan{}
+
+				continue
+			}
+
+			start = i
+		}
+	}
+
+	return spans
+}
+
+func htmlTextAlign(align HAlignment) string {
+	switch align {
+	case AlignLeft:
+		return "text-align: left"
+	case AlignRight:
+		return "text-align: right"
+	case AlignCenter:
+		return "text-align: center"
+	default:
+		return ""
+	}
+}
+
+// htmlStyleAttr builds a style attribute from a Formatter and some extra CSS declaration.
+func htmlStyleAttr(formatter Formatter, extra string) string {
+	declarations := make([]string, 0, 5)
+	if len(extra) > 0 {
+		declarations = append(declarations, extra)
+	}
+
+	if formatter != nil {
+		declarations = append(declarations, htmlFormatStyles(formatter(nil).Color())...)
+	}
+
+	if len(declarations) == 0 {
+		return ""
+	}
+
+	return ` style="` + strings.Join(declarations, "; ") + `"`
+}
+
+// htmlFormatStyles converts aurora colors and formats into CSS declarations.
+func htmlFormatStyles(color aurora.Color) []string {
+	var declarations []string
+
+	if fg, ok := htmlFgColor(color); ok {
+		declarations = append(declarations, "color: "+fg)
+	}
+
+	if bg, ok := htmlBgColor(color); ok {
+		declarations = append(declarations, "background-color: "+bg)
+	}
+
+	if color&aurora.BoldFm != 0 {
+		declarations = append(declarations, "font-weight: bold")
+	}
+
+	if color&aurora.FaintFm != 0 {
+		declarations = append(declarations, "opacity: 0.5")
+	}
+
+	if color&aurora.ItalicFm != 0 {
+		declarations = append(declarations, "font-style: italic")
+	}
+
+	var decorations []string
+	if color&(aurora.UnderlineFm|aurora.DoublyUnderlineFm) != 0 {
+		decorations = append(decorations, "underline")
+	}
+
+	if color&aurora.OverlinedFm != 0 {
+		decorations = append(decorations, "overline")
+	}
+
+	if color&aurora.CrossedOutFm != 0 {
+		decorations = append(decorations, "line-through")
+	}
+
+	if len(decorations) > 0 {
+		declarations = append(declarations, "text-decoration: "+strings.Join(decorations, " "))
+	}
+
+	return declarations
+}
+
+func htmlFgColor(color aurora.Color) (string, bool) {
+	const (
+		flagFg  = aurora.Color(1) << 14
+		shiftFg = 16
+	)
+
+	if color&flagFg == 0 {
+		return "", false
+	}
+
+	return htmlColorIndex(uint8(color >> shiftFg)), true
+}
+
+func htmlBgColor(color aurora.Color) (string, bool) {
+	const (
+		flagBg  = aurora.Color(1) << 15
+		shiftBg = 24
+	)
+
+	if color&flagBg == 0 {
+		return "", false
+	}
+
+	return htmlColorIndex(uint8(color >> shiftBg)), true
+}
+
+// htmlColorIndex converts a 256-colors terminal palette index into a CSS color.
+func htmlColorIndex(index uint8) string {
+	rgb := colorIndexRGB(index)
+
+	return fmt.Sprintf("#%02x%02x%02x", rgb[0], rgb[1], rgb[2])
+}
@@ -0,0 +1,98 @@
+package tablewriter
+
+import (
+	"testing"
+
+	"github.com/logrusorgru/aurora/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTML(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should render sections with alignment", func(t *testing.T) {
+		table, buf := NewBuffered(
+			WithHeader([]string{"Name", "Sign", "Rating"}),
+			WithRows([][]string{
+				{"A", "The Good", "500"},
+				{"B", "The <Bad>\nMan", "288"},
+			}),
+			WithFooter([]string{"", "Total", "788"}),
+			WithCaption("Movie ratings & scores."),
+			WithFormat(FormatHTML),
+		)
+		require.NoError(t, table.Render())
+
+		const want = `<table>
+  <caption>Movie ratings &amp; scores.</caption>
+  <thead>
+    <tr><th style="text-align: center">NAME</th><th style="text-align: center">SIGN</th><th style="text-align: center">RATING</th></tr>
+  </thead>
+  <tbody>
+    <tr><td>A</td><td>The Good</td><td style="text-align: right">500</td></tr>
+    <tr><td>B</td><td>The &lt;Bad&gt;<br>Man</td><td style="text-align: right">288</td></tr>
+  </tbody>
+  <tfoot>
+    <tr><td style="text-align: center"></td><td style="text-align: center">TOTAL</td><td style="text-align: center">788</td></tr>
+  </tfoot>
+</table>
+`
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should render merged cells as row spans", func(t *testing.T) {
+		table, buf := NewBuffered(
+			WithRows([][]string{
+				{"A", "The Good", "500"},
+				{"A", "The Very very Bad Man", "288"},
+				{"B", "The Very very Bad Man", "120"},
+				{"B", "The Ugly", "120"},
+			}),
+			WithColAlignment(map[int]HAlignment{2: AlignLeft}),
+			WithMergeCells(true),
+			WithFormat(FormatHTML),
+		)
+		require.NoError(t, table.Render())
+
+		const want = `<table>
+  <tbody>
+    <tr><td rowspan="2">A</td><td>The Good</td><td style="text-align: left">500</td></tr>
+    <tr><td rowspan="2">The Very very Bad Man</td><td style="text-align: left">288</td></tr>
+    <tr><td rowspan="2">B</td><td rowspan="2" style="text-align: left">120</td></tr>
+    <tr><td>The Ugly</td></tr>
+  </tbody>
+</table>
+`
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should render formatters as inline styles", func(t *testing.T) {
+		table, buf := NewBuffered(
+			WithHeader([]string{"Name", "Rating"}),
+			WithRows([][]string{
+				{"A", "500"},
+			}),
+			WithHeaderAlignment(AlignDefault),
+			WithHeaderFormatters(map[int]Formatter{
+				0: func(in interface{}) aurora.Value { return aurora.Bold(in).Underline() },
+			}),
+			WithColFormatters(map[int]Formatter{
+				0: func(in interface{}) aurora.Value { return aurora.Red(in).BgIndex(21) },
+				1: func(in interface{}) aurora.Value { return aurora.Gray(23, in).Italic() },
+			}),
+			WithFormat(FormatHTML),
+		)
+		require.NoError(t, table.Render())
+
+		const want = `<table>
+  <thead>
+    <tr><th style="font-weight: bold; text-decoration: underline">NAME</th><th>RATING</th></tr>
+  </thead>
+  <tbody>
+    <tr><td style="color: #cd0000; background-color: #0000ff">A</td><td style="text-align: right; color: #eeeeee; font-style: italic">500</td></tr>
+  </tbody>
+</table>
+`
+		checkEqual(t, buf.String(), want)
+	})
+}
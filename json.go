@@ -0,0 +1,229 @@
+package tablewriter
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"unicode"
+)
+
+// NewJSON builds a Table writer that streams its rows from JSON content.
+//
+// The content is either an array of objects or a stream of objects, such as newline-delimited JSON (NDJSON).
+// Objects are decoded one at a time and each object yields a row.
+//
+// The header is inferred from the union of the keys of all objects, in the order of their first appearance.
+// Use WithJSONSortedKeys to order columns by key.
+//
+// Nested objects are flattened into columns keyed by the path to each value, e.g. "address.city".
+// Use WithJSONFlatten to render nested objects as compact JSON instead. Arrays are rendered as compact JSON.
+//
+// Strings are rendered unquoted, null values and missing keys are rendered as empty cells.
+// Other values are rendered as they appear in the input.
+func NewJSON(reader io.Reader, opts ...Option) (*Table, error) {
+	table := New(opts...)
+	buffered := bufio.NewReader(reader)
+	isArray := peekJSONDelim(buffered) == '['
+
+	dec := json.NewDecoder(buffered)
+	loader := &jsonLoader{
+		index:     make(map[string]int),
+		separator: table.jsonKeySeparator,
+		flatten:   !table.jsonNoFlatten,
+	}
+
+	if isArray {
+		if _, err := dec.Token(); err != nil {
+			return nil, jsonError(dec, "reading array", err)
+		}
+	}
+
+	for count := 1; dec.More(); count++ {
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return nil, jsonError(dec, fmt.Sprintf("reading object #%d", count), err)
+		}
+
+		row, err := loader.row(raw)
+		if err != nil {
+			return nil, jsonError(dec, fmt.Sprintf("reading object #%d", count), err)
+		}
+
+		table.Append(row)
+	}
+
+	if isArray {
+		if _, err := dec.Token(); err != nil {
+			return nil, jsonError(dec, "closing array", err)
+		}
+	}
+
+	loader.apply(table)
+
+	return table, nil
+}
+
+// jsonLoader collects the columns found in a stream of JSON objects.
+type jsonLoader struct {
+	keys      []string
+	index     map[string]int
+	separator string
+	flatten   bool
+}
+
+// row converts a JSON object into a row, with cells in the order of the known columns.
+func (l *jsonLoader) row(raw json.RawMessage) ([]string, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 || raw[0] != '{' {
+		return nil, errors.New("expected an object")
+	}
+
+	row := make([]string, len(l.keys))
+	if err := l.readObject(raw, "", &row); err != nil {
+		return nil, err
+	}
+
+	return row, nil
+}
+
+// readObject reads the values of a JSON object into a row, flattening nested objects.
+func (l *jsonLoader) readObject(raw json.RawMessage, prefix string, row *[]string) error {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	if _, err := dec.Token(); err != nil { // opening brace
+		return err
+	}
+
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		key := prefix + token.(string) // object keys are always strings
+
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return err
+		}
+
+		value = bytes.TrimSpace(value)
+		if l.flatten && value[0] == '{' && !bytes.Equal(value, []byte("{}")) {
+			if err := l.readObject(value, key+l.separator, row); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		cell, err := jsonScalar(value)
+		if err != nil {
+			return err
+		}
+
+		l.set(row, key, cell)
+	}
+
+	return nil
+}
+
+func (l *jsonLoader) set(row *[]string, key, value string) {
+	col, ok := l.index[key]
+	if !ok {
+		col = len(l.keys)
+		l.index[key] = col
+		l.keys = append(l.keys, key)
+	}
+
+	if missing := col + 1 - len(*row); missing > 0 {
+		*row = append(*row, make([]string, missing)...)
+	}
+
+	(*row)[col] = value
+}
+
+// apply sets the header of the table, reordering columns by key if required.
+func (l *jsonLoader) apply(table *Table) {
+	if len(l.keys) == 0 {
+		return
+	}
+
+	if !table.jsonSortKeys {
+		table.header = l.keys
+
+		return
+	}
+
+	order := make([]int, len(l.keys))
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(i, j int) bool { return l.keys[order[i]] < l.keys[order[j]] })
+
+	header := make([]string, len(order))
+	for i, col := range order {
+		header[i] = l.keys[col]
+	}
+	table.header = header
+
+	for i, row := range table.rows {
+		sorted := make([]string, len(order))
+		for j, col := range order {
+			if col < len(row) {
+				sorted[j] = row[col]
+			}
+		}
+
+		table.rows[i] = sorted
+	}
+}
+
+// jsonScalar renders a JSON value as a cell.
+func jsonScalar(value json.RawMessage) (string, error) {
+	switch value[0] {
+	case '"':
+		var str string
+		err := json.Unmarshal(value, &str)
+
+		return str, err
+	case 'n':
+		return "", nil
+	case '{', '[':
+		var buf bytes.Buffer
+		err := json.Compact(&buf, value)
+
+		return buf.String(), err
+	default:
+		// numbers and booleans
+		return string(value), nil
+	}
+}
+
+// peekJSONDelim yields the first non-blank character of some JSON content, without consuming it.
+func peekJSONDelim(reader *bufio.Reader) byte {
+	for {
+		next, err := reader.Peek(1)
+		if err != nil {
+			return 0
+		}
+
+		if !unicode.IsSpace(rune(next[0])) {
+			return next[0]
+		}
+
+		_, _ = reader.Discard(1)
+	}
+}
+
+// jsonError wraps errors when reading JSON with the offset reached in the input.
+func jsonError(dec *json.Decoder, action string, err error) error {
+	if errors.Is(err, io.EOF) {
+		err = io.ErrUnexpectedEOF
+	}
+
+	return fmt.Errorf("json: offset %d: %s: %w", dec.InputOffset(), action, err)
+}
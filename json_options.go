@@ -0,0 +1,43 @@
+package tablewriter
+
+type jsonOptions struct {
+	jsonSortKeys     bool
+	jsonNoFlatten    bool
+	jsonKeySeparator string
+}
+
+func defaultJSONOptions() jsonOptions {
+	return jsonOptions{
+		jsonKeySeparator: ".",
+	}
+}
+
+// WithJSONSortedKeys orders the columns inferred from JSON objects by key.
+//
+// By default, columns are ordered by their first appearance in the input.
+func WithJSONSortedKeys(enabled bool) Option {
+	return func(o *options) {
+		o.jsonSortKeys = enabled
+	}
+}
+
+// WithJSONFlatten flattens nested JSON objects into several columns, keyed by the path to each value.
+//
+// When disabled, nested objects are rendered as compact JSON in a single column.
+// Arrays are always rendered as compact JSON.
+//
+// The default is enabled.
+func WithJSONFlatten(enabled bool) Option {
+	return func(o *options) {
+		o.jsonNoFlatten = !enabled
+	}
+}
+
+// WithJSONKeySeparator defines the separator used to join the keys of flattened nested JSON objects.
+//
+// The default is ".".
+func WithJSONKeySeparator(sep string) Option {
+	return func(o *options) {
+		o.jsonKeySeparator = sep
+	}
+}
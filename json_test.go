@@ -0,0 +1,88 @@
+package tablewriter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONFromReader(t *testing.T) {
+	t.Parallel()
+
+	const content = `[
+  {"name": "A", "sign": "The Good", "rating": 500, "address": {"city": "Paris", "zip": "75001"}},
+  {"name": "B", "rating": null, "tags": ["bad", "man"], "active": true},
+  {"sign": "The Ugly", "address": {"city": "Rome"}}
+]`
+
+	t.Run("should infer the header in order of first appearance, flattening nested objects", func(t *testing.T) {
+		table, err := NewJSON(strings.NewReader(content))
+		require.NoError(t, err)
+
+		require.Equal(t, []string{"name", "sign", "rating", "address.city", "address.zip", "tags", "active"}, table.header)
+		require.Equal(t, [][]string{
+			{"A", "The Good", "500", "Paris", "75001"},
+			{"B", "", "", "", "", `["bad","man"]`, "true"},
+			{"", "The Ugly", "", "Rome", "", "", ""},
+		}, table.rows)
+	})
+
+	t.Run("should sort keys and render nested objects compactly", func(t *testing.T) {
+		table, err := NewJSON(strings.NewReader(content),
+			WithJSONSortedKeys(true),
+			WithJSONFlatten(false),
+		)
+		require.NoError(t, err)
+
+		require.Equal(t, []string{"active", "address", "name", "rating", "sign", "tags"}, table.header)
+		require.Equal(t, [][]string{
+			{"", `{"city":"Paris","zip":"75001"}`, "A", "500", "The Good", ""},
+			{"true", "", "B", "", "", `["bad","man"]`},
+			{"", `{"city":"Rome"}`, "", "", "The Ugly", ""},
+		}, table.rows)
+	})
+
+	t.Run("should stream NDJSON and render as a table", func(t *testing.T) {
+		const ndjson = `{"name":"A","meta":{"id":1}}
+{"name":"B","meta":{"id":2}}
+`
+		table, err := NewJSON(strings.NewReader(ndjson), WithJSONKeySeparator("/"), WithTitledHeader(false))
+		require.NoError(t, err)
+
+		table, buf := NewBuffered(WithHeader(table.header), WithRows(table.rows), WithTitledHeader(false))
+		require.NoError(t, table.Render())
+
+		const want = `+------+---------+
+| name | meta/id |
++------+---------+
+| A    |       1 |
+| B    |       2 |
++------+---------+
+`
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should load empty content", func(t *testing.T) {
+		for _, content := range []string{"", "  \n", "[]"} {
+			table, err := NewJSON(strings.NewReader(content))
+			require.NoError(t, err)
+			require.Empty(t, table.header)
+			require.Empty(t, table.rows)
+		}
+	})
+
+	t.Run("should report errors", func(t *testing.T) {
+		_, err := NewJSON(strings.NewReader(`[{"name":"A"}, 12]`))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "reading object #2: expected an object")
+
+		_, err = NewJSON(strings.NewReader(`{"name":"A"}` + "\n" + `{"name":`))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "json: offset 13: reading object #2")
+
+		_, err = NewJSON(strings.NewReader(`[{"name":"A"}`))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unexpected end of JSON input")
+	})
+}
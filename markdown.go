@@ -0,0 +1,148 @@
+package tablewriter
+
+import (
+	"fmt"
+	"strings"
+
+	wrap "github.com/fredbi/tablewriter/tablewrappers"
+)
+
+const markdownLineBreak = "<br>"
+
+var markdownEscaper = strings.NewReplacer(`\`, `\\`, `|`, `\|`)
+
+// renderMarkdown renders the table as a GitHub-flavored markdown table.
+//
+// Cells are not wrapped: multi-lines cells are rendered with <br> line breaks. Hyperlinks are rendered as markdown links.
+// The delimiter row reflects the alignment of each column.
+//
+// Since markdown tables don't support footers, the rows of the footer are rendered as last rows in bold face.
+// The caption is rendered as a paragraph below the table.
+func (t *Table) renderMarkdown() {
+	titler := t.headerPrepadder()
+
+	header := t.markdownCells(t.headerValues, titler, identity)
+	rows := make([][]string, 0, len(t.rowValues))
+	for i, row := range t.rowValues {
+		cells := t.markdownCells(row, identity, identity)
+		for col, cell := range cells {
+			if target := t.cellLink(i, col); len(target) > 0 && len(cell) > 0 {
+				cells[col] = "[" + cell + "](" + target + ")"
+			}
+		}
+
+		rows = append(rows, cells)
+	}
+	footers := make([][]string, 0, len(t.footerValues))
+	for _, footer := range t.footerValues {
+		footers = append(footers, t.markdownCells(footer, titler, markdownBold))
+	}
+
+	widths := make([]int, t.numColumns)
+	for _, row := range append(append([][]string{header}, rows...), footers...) {
+		for col, cell := range row {
+			if w := wrap.DisplayWidth(cell); w > widths[col] {
+				widths[col] = w
+			}
+		}
+	}
+
+	headerPadder := t.headerAlign.padder()
+	t.printMarkdownRow(header, widths, func(_ int) padFunc { return headerPadder })
+	t.printMarkdownDelimiter(widths)
+
+	for i, row := range rows {
+		t.printMarkdownRow(row, widths, t.rowAligner(i))
+	}
+
+	footerPadder := t.footerAlign.padder()
+	for _, footer := range footers {
+		t.printMarkdownRow(footer, widths, func(_ int) padFunc { return footerPadder })
+	}
+
+	if len(t.captionText) > 0 {
+		fmt.Fprint(t.out, t.newLine, t.captionText, t.newLine)
+	}
+}
+
+// markdownCells converts a row into markdown cells, one per column.
+//
+// Pipes and backslashes are escaped and line breaks are rendered with <br>.
+func (t *Table) markdownCells(row []string, prepadder, decorate transformer) []string {
+	cells := make([]string, t.numColumns)
+
+	for col := range cells {
+		if col >= len(row) {
+			continue
+		}
+
+		lines := splitLines(row[col])
+		for i, line := range lines {
+			lines[i] = markdownEscaper.Replace(strings.TrimSpace(prepadder(line)))
+		}
+
+		if cell := strings.Join(lines, markdownLineBreak); len(cell) > 0 {
+			cells[col] = decorate(cell)
+		}
+	}
+
+	return cells
+}
+
+func (t *Table) printMarkdownRow(cells []string, widths []int, aligner colAligner) {
+	for col, cell := range cells {
+		fmt.Fprint(t.out, COLUMN, SPACE, aligner(col)(cell, SPACE, widths[col]), SPACE)
+	}
+
+	fmt.Fprint(t.out, COLUMN, t.newLine)
+}
+
+// printMarkdownDelimiter prints the delimiter row below the header, with alignment markers.
+//
+// Columns with the default alignment are right-aligned when all their values are numerical, like in text tables.
+func (t *Table) printMarkdownDelimiter(widths []int) {
+	for col, width := range widths {
+		left, right := ROW, ROW
+
+		align := t.columnsAlign[col]
+		if align == AlignDefault && t.isNumericalColumn(col) {
+			align = AlignRight
+		}
+
+		switch align {
+		case AlignLeft:
+			left = ":"
+		case AlignRight:
+			right = ":"
+		case AlignCenter:
+			left, right = ":", ":"
+		}
+
+		fmt.Fprint(t.out, COLUMN, left, strings.Repeat(ROW, width), right)
+	}
+
+	fmt.Fprint(t.out, COLUMN, t.newLine)
+}
+
+// isNumericalColumn tells if all the non-blank values of the rows in a column are numerical.
+func (t *Table) isNumericalColumn(col int) bool {
+	numerical := false
+	for _, row := range t.rowValues {
+		value := strings.TrimSpace(row[col])
+		if len(value) == 0 {
+			continue
+		}
+
+		if !isNumerical(value) {
+			return false
+		}
+
+		numerical = true
+	}
+
+	return numerical
+}
+
+func markdownBold(in string) string {
+	return "**" + in + "**"
+}
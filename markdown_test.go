@@ -0,0 +1,98 @@
+package tablewriter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarkdown(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should render alignment markers", func(t *testing.T) {
+		table, buf := NewBuffered(
+			WithHeader([]string{"Left", "Center", "Right", "Default"}),
+			WithRows([][]string{
+				{"a", "b", "c", "d"},
+				{"1", "2", "3", "4"},
+			}),
+			WithColAlignment(map[int]HAlignment{
+				0: AlignLeft,
+				1: AlignCenter,
+				2: AlignRight,
+			}),
+			WithMarkdown(true),
+		)
+		require.NoError(t, table.Render())
+
+		const want = `| LEFT | CENTER | RIGHT | DEFAULT |
+|:-----|:------:|------:|---------|
+| a    |   b    |     c | d       |
+| 1    |   2    |     3 |       4 |
+`
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should escape pipes and render multi-line cells", func(t *testing.T) {
+		table, buf := NewBuffered(
+			WithHeader([]string{"Expression", "Description"}),
+			WithRows([][]string{
+				{`a|b`, "first line\nsecond line"},
+				{`c\d`, "single line"},
+			}),
+			WithColWidth(5),
+			WithTitledHeader(false),
+			WithMarkdown(true),
+		)
+		require.NoError(t, table.Render())
+
+		const want = `| Expression |        Description        |
+|------------|---------------------------|
+| a\|b       | first line<br>second line |
+| c\\d       | single line               |
+`
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should render footer and caption", func(t *testing.T) {
+		table, buf := NewBuffered(
+			WithHeader([]string{"Item", "Price"}),
+			WithRows([][]string{
+				{"Domain name", "$10.98"},
+				{"January Hosting", "$54.95"},
+			}),
+			WithFooter([]string{"Total", "$65.93"}),
+			WithCaption("Invoice."),
+			WithMarkdown(true),
+		)
+		require.NoError(t, table.Render())
+
+		const want = `|      ITEM       |   PRICE    |
+|-----------------|-----------:|
+| Domain name     |     $10.98 |
+| January Hosting |     $54.95 |
+|    **TOTAL**    | **$65.93** |
+
+Invoice.
+`
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should revert to text when disabled", func(t *testing.T) {
+		table, buf := NewBuffered(
+			WithHeader([]string{"Item"}),
+			WithRows([][]string{{"a|b"}}),
+			WithMarkdown(true),
+			WithMarkdown(false),
+		)
+		require.NoError(t, table.Render())
+
+		const want = `+------+
+| ITEM |
++------+
+| a|b  |
++------+
+`
+		checkEqual(t, buf.String(), want)
+	})
+}
@@ -36,9 +36,7 @@ type (
 	Option func(*options)
 
 	separatorOptions struct {
-		pCenter string
-		pRow    string
-		pColumn string
+		style   Style
 		newLine string
 	}
 
@@ -47,6 +45,10 @@ type (
 		footerAlign    HAlignment
 		cellAlign      HAlignment
 		perColumnAlign map[int]HAlignment
+
+		// vertical alignment
+		vAlign          VAlignment
+		perColumnVAlign map[int]VAlignment
 	}
 
 	wrapOptions struct {
@@ -54,16 +56,22 @@ type (
 	}
 
 	options struct {
-		rows        [][]string // input rows
-		header      []string
-		footer      []string
-		captionText string
+		rows         [][]string     // input rows
+		richCells    map[int][]Cell // individually formatted cells, by row
+		rowSpans     []cellSpan     // cells spanning several rows
+		rowDepths    map[int]int    // depth of tree rows, by row
+		header       []string
+		headerGroups [][]Cell // groups of columns above the header, from top to bottom
+		footer       []string
+		footerRows   []FooterRow // rows of the footer computed from the data
+		captionText  string
 
 		// rendering target
-		out io.Writer
+		out    io.Writer
+		format Format
 
 		// width & height
-		colWidth    map[int]int // min width for a column
+		colMinWidth map[int]int // min width for a column
 		colMaxWidth map[int]int // max width for a column
 		maxColWidth int
 
@@ -90,8 +98,26 @@ type (
 		// formatting
 		formatOptions
 
+		// terminal colors
+		colorOptions
+
 		// horizontal alignment
 		alignOptions
+
+		// CSV output
+		csvOptions
+
+		// JSON input
+		jsonOptions
+
+		// database/sql input
+		sqlOptions
+
+		// struct input
+		structOptions
+
+		// grouping of rows
+		groupOptions
 	}
 )
 
@@ -103,7 +129,7 @@ func defaultOptions(opts []Option) *options {
 	o := &options{
 		out:                  os.Stdout,
 		rows:                 [][]string{},
-		colWidth:             make(map[int]int),
+		colMinWidth:          make(map[int]int),
 		colMaxWidth:          make(map[int]int),
 		captionText:          "",
 		maxColWidth:          MaxColWidth,
@@ -111,6 +137,12 @@ func defaultOptions(opts []Option) *options {
 		separatorOptions:     defaultSeparatorOptions(),
 		alignOptions:         defaultAlignOptions(),
 		formatOptions:        defaultFormatOptions(),
+		colorOptions:         defaultColorOptions(),
+		csvOptions:           defaultCSVOptions(),
+		jsonOptions:          defaultJSONOptions(),
+		sqlOptions:           defaultSQLOptions(),
+		structOptions:        defaultStructOptions(),
+		groupOptions:         defaultGroupOptions(),
 		separatorAfterHeader: true,
 		separatorAfterFooter: true,
 		borders:              Border{Left: true, Right: true, Bottom: true, Top: true},
@@ -122,6 +154,8 @@ func defaultOptions(opts []Option) *options {
 		apply(o)
 	}
 
+	o.resolveColorProfile()
+
 	return o
 }
 
@@ -133,9 +167,7 @@ func defaultWrapOptions() wrapOptions {
 
 func defaultSeparatorOptions() separatorOptions {
 	return separatorOptions{
-		pCenter: CENTER,
-		pRow:    ROW,
-		pColumn: COLUMN,
+		style:   StyleASCII,
 		newLine: NEWLINE,
 	}
 }
@@ -146,6 +178,7 @@ func defaultAlignOptions() alignOptions {
 		footerAlign:    AlignCenter,
 		cellAlign:      AlignDefault,
 		perColumnAlign: make(map[int]HAlignment),
+		vAlign:         VAlignTop,
 	}
 }
 
@@ -174,6 +207,19 @@ func WithHeader(header []string) Option {
 	}
 }
 
+// WithHeaderGroups specifies several levels of groups of columns, rendered above the header.
+//
+// Levels are specified from top to bottom. Each group is a Cell spanning the number of columns set by its ColSpan,
+// e.g. "Latency" above "p50", "p95" and "p99". Groups are titled like the header. Unless the Cell specifies its own
+// Formatter or Align, groups are formatted with the header formatter of their first column and aligned like the header.
+//
+// Groups require a header. They are rendered in text and HTML tables, but not in other formats.
+func WithHeaderGroups(groups ...[]Cell) Option {
+	return func(o *options) {
+		o.headerGroups = groups
+	}
+}
+
 // WithFooter specifies the footer fields for this table.
 func WithFooter(footer []string) Option {
 	return func(o *options) {
@@ -253,15 +299,16 @@ func WithMaxTableWidth(width int, opts ...wrap.Option) Option {
 func makeMatrix(t *Table) [][]string {
 	var extra int
 
-	h := t.Header()
+	h := t.headerValues
 	if len(h) > 0 {
 		extra++
 	}
-	f := t.Footer()
-	if len(f) > 0 {
+	var f []string
+	if len(t.footer) > 0 {
+		f = t.footerValues[0]
 		extra++
 	}
-	r := t.Rows()
+	r := t.rowValues
 
 	matrix := make([][]string, 0, len(r)+extra)
 	if len(h) > 0 {
@@ -278,10 +325,12 @@ func makeMatrix(t *Table) [][]string {
 // WithCenterSeparator defines the string used to represent intersections of
 // the table grid.
 //
+// This sets all the junctions of the current Style to the same glyph.
+//
 // The default is '+'.
 func WithCenterSeparator(sep string) Option {
 	return func(o *options) {
-		o.pCenter = sep
+		o.style.setJunctions(sep)
 	}
 }
 
@@ -290,7 +339,8 @@ func WithCenterSeparator(sep string) Option {
 // The default is '-'.
 func WithRowSeparator(sep string) Option {
 	return func(o *options) {
-		o.pRow = sep
+		o.style.Horizontal = sep
+		o.style.HeaderHorizontal = sep
 	}
 }
 
@@ -387,7 +437,7 @@ func WithPadding(padding string) Option {
 // The default is '|'.
 func WithColumnSeparator(sep string) Option {
 	return func(o *options) {
-		o.pColumn = sep
+		o.style.Vertical = sep
 	}
 }
 
@@ -418,16 +468,18 @@ func WithColMaxWidths(maxWidths map[int]int) Option {
 	}
 }
 
-// WithMarkdown reproduces classifical markdown tables.
+// WithMarkdown renders GitHub-flavored markdown tables.
 //
-// This option is a shortcut to:
+// This option is a shortcut to WithFormat(FormatMarkdown).
 //
-//	WithCenterSeparator("|")
-//	WithBorders(Border{Left: true, Top: false, Right: true, Bottom: false})
+// Disabling this option reverts to FormatText.
 func WithMarkdown(enabled bool) Option {
 	return func(o *options) {
-		o.borders = Border{Left: true, Top: false, Right: true, Bottom: false}
-		o.pCenter = "|"
+		if enabled {
+			o.format = FormatMarkdown
+		} else {
+			o.format = FormatText
+		}
 	}
 }
 
@@ -450,7 +502,7 @@ func WithMergeCells(enabled bool) Option {
 // WithColMinWidth specifies the minimum width of columns.
 func WithColMinWidth(column int, width int) Option {
 	return func(o *options) {
-		o.colWidth[column] = width
+		o.colMinWidth[column] = width
 	}
 }
 
@@ -460,3 +512,21 @@ func WithColAlignment(align map[int]HAlignment) Option {
 		o.perColumnAlign = align
 	}
 }
+
+// WithVAlignment defines the vertical alignment of cells in multi-lines rows, for the header, the rows and the footer.
+//
+// The default is TOP.
+func WithVAlignment(align VAlignment) Option {
+	return func(o *options) {
+		o.vAlign = align
+	}
+}
+
+// WithColVAlignment defines the vertical alignment for a set of columns.
+//
+// This overrides the setting defined by WithVAlignment. Cells appended with a VAlign (see AppendRich) are not affected.
+func WithColVAlignment(align map[int]VAlignment) Option {
+	return func(o *options) {
+		o.perColumnVAlign = align
+	}
+}
@@ -11,6 +11,9 @@ type (
 	// HAlignment describes how to horizontally align an element in a cell.
 	HAlignment uint8
 
+	// VAlignment describes how to vertically align the lines of a cell, in a row with taller cells.
+	VAlignment uint8
+
 	padFunc    func(in string, pad string, width int) string
 	colAligner func(col int) padFunc
 )
@@ -23,6 +26,14 @@ const (
 	AlignLeft
 )
 
+// Vertical alignment
+const (
+	VAlignDefault VAlignment = iota
+	VAlignTop
+	VAlignMiddle
+	VAlignBottom
+)
+
 var (
 	rexNumerical = regexp.MustCompile(`^\s*((\+|-)?\pS)?(\+|-)?((\pN+?)|(\pN{3}[\s,]))+([\.,]\pN*)?(%|\pS|([eE][\+-]{0,1}\pN+))?\s*$`)
 )
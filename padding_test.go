@@ -250,3 +250,81 @@ func TestPadCenter(t *testing.T) {
 		require.Equal(t, expected, padded)
 	})
 }
+
+func TestVAlignment(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should pad lines according to the vertical alignment", func(t *testing.T) {
+		t.Parallel()
+
+		lines := []string{"a"}
+		require.Equal(t, []string{"a", "", "", ""}, valignLines(lines, 4, VAlignTop))
+		require.Equal(t, []string{"", "a", "", ""}, valignLines(lines, 4, VAlignMiddle))
+		require.Equal(t, []string{"", "", "", "a"}, valignLines(lines, 4, VAlignBottom))
+		require.Equal(t, []string{"a"}, valignLines(lines, 1, VAlignBottom))
+	})
+
+	t.Run("should align cells at table, column and cell level", func(t *testing.T) {
+		t.Parallel()
+
+		table, buf := NewBuffered(
+			WithHeader([]string{"Name", "Description", "Qty"}),
+			WithFooter([]string{"total\nitems", "", "3"}),
+			WithWrap(false),
+			WithRowLine(true),
+			WithVAlignment(VAlignMiddle),
+			WithColVAlignment(map[int]VAlignment{2: VAlignBottom}),
+		)
+		table.Append([]string{"a", "line 1\nline 2\nline 3", "1"})
+		table.AppendRich([]Cell{{Value: "b", VAlign: VAlignTop}, {Value: "x\ny\nz\nw"}, {Value: "2"}})
+		table.AppendRich([]Cell{{Value: "span\nme", RowSpan: 2, VAlign: VAlignBottom}, {Value: "p\nq"}, {Value: "3"}})
+		table.Append([]string{"r\ns\nt", "4"})
+		require.NoError(t, table.Render())
+
+		const want = `+-------+-------------+-----+
+| NAME  | DESCRIPTION | QTY |
++-------+-------------+-----+
+|       | line 1      |     |
+| a     | line 2      |     |
+|       | line 3      |   1 |
++-------+-------------+-----+
+| b     | x           |     |
+|       | y           |     |
+|       | z           |     |
+|       | w           |   2 |
++-------+-------------+-----+
+|       | p           |     |
+|       | q           |   3 |
++       +-------------+-----+
+|       | r           |     |
+| span  | s           |     |
+| me    | t           |   4 |
++-------+-------------+-----+
+| TOTAL |                   |
+| ITEMS |                3  |
++-------+-------------+-----+
+`
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should merge identical cells regardless of the vertical alignment", func(t *testing.T) {
+		t.Parallel()
+
+		table, buf := NewBuffered(
+			WithWrap(false),
+			WithMergeCells(true),
+			WithVAlignment(VAlignBottom),
+		)
+		table.Append([]string{"a", "1\n2"})
+		table.Append([]string{"a", "3"})
+		require.NoError(t, table.Render())
+
+		const want = `+---+---+
+|   | 1 |
+| a | 2 |
+|   | 3 |
++---+---+
+`
+		checkEqual(t, buf.String(), want)
+	})
+}
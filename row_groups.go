@@ -0,0 +1,201 @@
+package tablewriter
+
+import (
+	"strings"
+
+	wrap "github.com/fredbi/tablewriter/tablewrappers"
+)
+
+const groupLabelSeparator = " / "
+
+// virtualRow is a row of the table which is not part of the input, such as the label or a subtotal of a group.
+type virtualRow struct {
+	lines   [][]string
+	isLabel bool
+}
+
+// prepareRowGroups groups the rows by the values of the key columns.
+//
+// Zebra stripes restart with each group, following the position of the rows within their group.
+//
+// The label and the subtotals of each group are laid out as virtual rows, with indices following the rows of the table.
+// Labels are registered as spans over all the columns.
+func (t *Table) prepareRowGroups() {
+	t.rowOrder = nil
+	t.rowPositions = nil
+	t.virtualRows = nil
+
+	if len(t.groupBy) == 0 || len(t.rows) == 0 {
+		return
+	}
+
+	var (
+		groups [][]int
+		labels []string
+	)
+	index := make(map[string]int)
+
+	for i, row := range t.rows {
+		key, label := t.groupKey(row)
+		group, ok := index[key]
+		if !ok {
+			group = len(groups)
+			index[key] = group
+			groups = append(groups, nil)
+			labels = append(labels, label)
+		}
+
+		groups[group] = append(groups[group], i)
+	}
+
+	t.rowPositions = make([]int, len(t.rows))
+
+	for g, members := range groups {
+		t.rowOrder = append(t.rowOrder, t.addGroupLabel(labels[g]))
+		t.rowOrder = append(t.rowOrder, members...)
+
+		for position, row := range members {
+			t.rowPositions[row] = position
+		}
+
+		for _, subtotal := range t.groupSubtotals {
+			t.rowOrder = append(t.rowOrder, t.addGroupSubtotal(subtotal, members))
+		}
+	}
+}
+
+// groupKey yields the values of the key columns of a row, as a key and as a label.
+func (t *Table) groupKey(row []string) (string, string) {
+	values := make([]string, 0, len(t.groupBy))
+	labels := make([]string, 0, len(t.groupBy))
+
+	for _, col := range t.groupBy {
+		var value string
+		if col >= 0 && col < len(row) {
+			value = strings.TrimSpace(row[col])
+		}
+
+		values = append(values, value)
+		if len(value) > 0 {
+			labels = append(labels, value)
+		}
+	}
+
+	return strings.Join(values, "\x00"), strings.Join(labels, groupLabelSeparator)
+}
+
+// addGroupLabel lays out the label row of a group and yields its index.
+func (t *Table) addGroupLabel(label string) int {
+	idx := len(t.lines) + len(t.virtualRows)
+	cells := make([][]string, t.numColumns)
+	cells[0] = wrap.PreserveEscapes(splitLines(label))
+	width := wrap.CellWidth(cells[0])
+	t.setRowHeight(idx, max(len(cells[0]), 1))
+
+	if t.numColumns > 1 {
+		if t.spanOrigins == nil {
+			t.spanOrigins = make(map[cellPos]cellSpan)
+		}
+
+		span := cellSpan{cellPos: cellPos{row: idx, col: 0}, rows: 1, cols: t.numColumns}
+		for col := 0; col < t.numColumns; col++ {
+			t.spanOrigins[cellPos{row: idx, col: col}] = span
+		}
+
+		t.spreadWidth(0, t.numColumns, width)
+	} else {
+		t.setColWidth(0, width)
+	}
+
+	t.virtualRows = append(t.virtualRows, virtualRow{lines: cells, isLabel: true})
+
+	return idx
+}
+
+// addGroupSubtotal lays out a subtotal row of a group and yields its index.
+func (t *Table) addGroupSubtotal(subtotal FooterRow, members []int) int {
+	idx := len(t.lines) + len(t.virtualRows)
+	cells := make([][]string, t.numColumns)
+	t.setRowHeight(idx, 1)
+
+	for col, aggregate := range subtotal {
+		if col < 0 || col >= t.numColumns || aggregate == nil {
+			continue
+		}
+
+		cells[col] = wrap.PreserveEscapes(splitLines(aggregate(t.columnValues(col, members))))
+		t.setColWidth(col, wrap.CellWidth(cells[col]))
+		t.setRowHeight(idx, len(cells[col]))
+	}
+
+	t.virtualRows = append(t.virtualRows, virtualRow{lines: cells})
+
+	return idx
+}
+
+// displayOrder yields the indices of the rows in the order of rendering, including virtual rows.
+func (t *Table) displayOrder() []int {
+	if t.rowOrder != nil {
+		return t.rowOrder
+	}
+
+	order := make([]int, len(t.lines))
+	for i := range order {
+		order[i] = i
+	}
+
+	return order
+}
+
+// firstRowIdx yields the index of the first row rendered.
+func (t *Table) firstRowIdx() int {
+	if len(t.rowOrder) > 0 {
+		return t.rowOrder[0]
+	}
+
+	return 0
+}
+
+// lastRowIdx yields the index of the last row rendered.
+func (t *Table) lastRowIdx() int {
+	if len(t.rowOrder) > 0 {
+		return t.rowOrder[len(t.rowOrder)-1]
+	}
+
+	return len(t.lines) - 1
+}
+
+// isGroupLabel tells if a row is the label row of a group.
+func (t *Table) isGroupLabel(row int) bool {
+	virtual := row - len(t.lines)
+
+	return virtual >= 0 && virtual < len(t.virtualRows) && t.virtualRows[virtual].isLabel
+}
+
+// printVirtualRow renders the label or a subtotal row of a group.
+func (t *Table) printVirtualRow(rowIdx int) {
+	row := t.virtualRows[rowIdx-len(t.lines)]
+	maxHeight := t.rowMaxHeight[rowIdx]
+	columns := normalizeRowHeight(row.lines, maxHeight, t.rowVAligner(rowIdx))
+
+	if row.isLabel {
+		params := make(map[int]Formatter, 1)
+		if t.groupParams != nil {
+			params[t.lastCol()] = t.groupParams
+		}
+
+		t.printRowLines(columns, rowIdx, maxHeight,
+			func(_ int) padFunc { return padRight },
+			t.transformer(params),
+			func(_ int) Formatter { return nil },
+		)
+
+		return
+	}
+
+	t.printRowLines(columns, rowIdx, maxHeight,
+		func(col int) padFunc { return t.columnsAlign[col].padder() },
+		t.transformer(t.columnsParams),
+		func(_ int) Formatter { return nil },
+	)
+}
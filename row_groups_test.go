@@ -0,0 +1,128 @@
+package tablewriter
+
+import (
+	"testing"
+
+	"github.com/logrusorgru/aurora/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRowGroups(t *testing.T) {
+	t.Parallel()
+
+	options := func(opts ...Option) []Option {
+		return append([]Option{
+			WithHeader([]string{"Region", "Product", "Sales"}),
+			WithRows([][]string{{"eu", "apple", "1,200"}, {"us", "pear", "300.5"}, {"eu", "pear", "87"}}),
+			WithGroupBy(0),
+		}, opts...)
+	}
+
+	t.Run("should render groups under a label row, in the order of their first row", func(t *testing.T) {
+		table, buf := NewBuffered(options(WithStyle(StyleLight))...)
+		require.NoError(t, table.Render())
+
+		const want = `┌────────┬─────────┬───────┐
+│ REGION │ PRODUCT │ SALES │
+├────────┴─────────┴───────┤
+│ eu                       │
+│ eu     │ apple   │ 1,200 │
+│ eu     │ pear    │    87 │
+│ us                       │
+│ us     │ pear    │ 300.5 │
+└────────┴─────────┴───────┘
+`
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should render subtotals and separators between groups", func(t *testing.T) {
+		table, buf := NewBuffered(options(
+			WithStyle(StyleLight),
+			WithGroupSubtotals(FooterRow{1: Label("subtotal"), 2: Sum(nil)}),
+			WithGroupSeparator(true),
+			WithFooterRows(FooterRow{1: Label("Total"), 2: Sum(nil)}),
+		)...)
+		require.NoError(t, table.Render())
+
+		const want = `┌────────┬──────────┬────────┐
+│ REGION │ PRODUCT  │ SALES  │
+├────────┴──────────┴────────┤
+│ eu                         │
+│ eu     │ apple    │  1,200 │
+│ eu     │ pear     │     87 │
+│        │ subtotal │   1287 │
+├────────┴──────────┴────────┤
+│ us                         │
+│ us     │ pear     │  300.5 │
+│        │ subtotal │  300.5 │
+├────────┼──────────┼────────┤
+│           TOTAL   │ 1587.5 │
+└────────┴──────────┴────────┘
+`
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should omit junctions below and above labels with row lines", func(t *testing.T) {
+		table, buf := NewBuffered(
+			WithHeader([]string{"a", "b"}),
+			WithRows([][]string{{"x", "1"}, {"y", "2"}}),
+			WithGroupBy(0, 1),
+			WithRowLine(true),
+			WithStyle(StyleLight),
+			WithGroupFormatter(aurora.Bold),
+		)
+		require.NoError(t, table.Render())
+
+		const want = "┌───┬───┐\n" +
+			"│ A │ B │\n" +
+			"├───┴───┤\n" +
+			"│ \x1b[1mx / 1\x1b[0m │\n" +
+			"├───┬───┤\n" +
+			"│ x │ 1 │\n" +
+			"├───┴───┤\n" +
+			"│ \x1b[1my / 2\x1b[0m │\n" +
+			"├───┬───┤\n" +
+			"│ y │ 2 │\n" +
+			"└───┴───┘\n"
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should alternate zebra stripes within each group", func(t *testing.T) {
+		band := func(in interface{}) aurora.Value { return aurora.Reverse(in) }
+		table, buf := NewBuffered(
+			WithHeader([]string{"Key", "Value"}),
+			WithRows([][]string{{"A", "1"}, {"B", "2"}, {"A", "3"}, {"B", "4"}, {"A", "5"}, {"B", "6"}}),
+			WithGroupBy(0),
+			WithZebraStripes(band, 1),
+		)
+		require.NoError(t, table.Render())
+
+		for row, striped := range []bool{false, false, true, true, false, false} {
+			require.Equalf(t, striped, table.rowBand(row) != nil, "unexpected band for row %d", row)
+		}
+
+		const (
+			on  = "\x1b[7m"
+			off = "\x1b[0m"
+		)
+		stripe := func(a, b string) string {
+			return "|" + on + " " + off + on + a + "  " + off + on + " " + off +
+				"|" + on + " " + off + on + "    " + b + off + on + " " + off + "|\n"
+		}
+
+		const head = "+-----+-------+\n" +
+			"| KEY | VALUE |\n" +
+			"+-----+-------+\n"
+		want := head +
+			"| A           |\n" +
+			"| A   |     1 |\n" +
+			stripe("A", "3") +
+			"| A   |     5 |\n" +
+			"| B           |\n" +
+			"| B   |     2 |\n" +
+			stripe("B", "4") +
+			"| B   |     6 |\n" +
+			"+-----+-------+\n"
+		checkEqual(t, buf.String(), want)
+	})
+}
@@ -0,0 +1,295 @@
+package tablewriter
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/logrusorgru/aurora/v4"
+)
+
+type (
+	// Rule picks a Formatter for a cell of a row, at render time.
+	//
+	// A rule returns nil when it doesn't apply to the cell.
+	//
+	// Rules are evaluated on the raw values of the cells: unlike pre-formatted values,
+	// this keeps the automatic alignment of numbers.
+	Rule func(CellContext) Formatter
+
+	// CellContext describes the cell evaluated by a Rule.
+	CellContext struct {
+		// Value is the raw value of the cell
+		Value string
+
+		// Row is the index of the row, starting at 0
+		Row int
+
+		// Col is the index of the column, starting at 0
+		Col int
+
+		// Values are the raw values of all the cells in the row
+		Values []string
+
+		table *Table
+	}
+
+	// numericRange is the range of numeric values in a column.
+	numericRange struct {
+		min, max float64
+		ok       bool
+	}
+)
+
+// WithRules defines rules to format individual cells depending on their value, e.g. to highlight values
+// above a threshold.
+//
+// All the rules that apply to a cell are combined, in order. Rules take precedence over the formatters of
+// the columns (see WithColFormatters). Cells appended with a Formatter (see AppendRich) are not affected.
+//
+// Rules apply to rows only, not to the header or footer.
+func WithRules(rules ...Rule) Option {
+	return func(o *options) {
+		o.rules = append(o.rules, rules...)
+	}
+}
+
+// Number parses the value of the cell as a number.
+//
+// Thousands separators, leading currency symbols and trailing percent signs are ignored.
+func (c CellContext) Number() (float64, bool) {
+	return parseNumber(c.Value)
+}
+
+// ColumnRange yields the lowest and highest numeric values found in the column of the cell.
+//
+// It returns false if the column contains no numeric value.
+func (c CellContext) ColumnRange() (min, max float64, ok bool) {
+	if c.table == nil {
+		return 0, 0, false
+	}
+
+	r := c.table.columnRange(c.Col)
+
+	return r.min, r.max, r.ok
+}
+
+// When formats the cells of a column for which the predicate holds. If col is negative, all columns are considered.
+func When(col int, predicate func(value string) bool, formatter Formatter) Rule {
+	return func(c CellContext) Formatter {
+		if (col >= 0 && c.Col != col) || !predicate(c.Value) {
+			return nil
+		}
+
+		return formatter
+	}
+}
+
+// WhenRow formats all the cells of a row, when the predicate holds for the value of the column col in this row.
+//
+// Unlike When, col must designate a column of the row: rows are never formatted when col is negative
+// or beyond the last column.
+func WhenRow(col int, predicate func(value string) bool, formatter Formatter) Rule {
+	return func(c CellContext) Formatter {
+		if col < 0 || col >= len(c.Values) || !predicate(c.Values[col]) {
+			return nil
+		}
+
+		return formatter
+	}
+}
+
+// Equals is a predicate for When and WhenRow that holds if the value, without leading or trailing blanks,
+// equals the expected one.
+func Equals(expected string) func(string) bool {
+	return func(value string) bool {
+		return strings.TrimSpace(value) == expected
+	}
+}
+
+// Above is a predicate for When and WhenRow that holds for numeric values strictly greater than the threshold.
+func Above(threshold float64) func(string) bool {
+	return func(value string) bool {
+		n, ok := parseNumber(value)
+
+		return ok && n > threshold
+	}
+}
+
+// Below is a predicate for When and WhenRow that holds for numeric values strictly lower than the threshold.
+func Below(threshold float64) func(string) bool {
+	return func(value string) bool {
+		n, ok := parseNumber(value)
+
+		return ok && n < threshold
+	}
+}
+
+// Thresholds formats the numeric values of a column on a scale.
+//
+// Thresholds must be sorted in increasing order, with one more formatter than thresholds:
+// values below thresholds[0] use formatters[0], values from thresholds[i-1] and below thresholds[i] use formatters[i],
+// and values from the last threshold use the last formatter. A nil formatter leaves values unformatted.
+func Thresholds(col int, thresholds []float64, formatters ...Formatter) Rule {
+	return func(c CellContext) Formatter {
+		if c.Col != col {
+			return nil
+		}
+
+		n, ok := c.Number()
+		if !ok {
+			return nil
+		}
+
+		bucket := 0
+		for bucket < len(thresholds) && n >= thresholds[bucket] {
+			bucket++
+		}
+
+		if bucket >= len(formatters) {
+			return nil
+		}
+
+		return formatters[bucket]
+	}
+}
+
+// Heatmap formats the numeric values of a column with a gradient, from the lowest to the highest value found
+// in the column.
+//
+// The gradient is a palette of formatters. By default, the palette goes from a green to a red background,
+// through yellow.
+func Heatmap(col int, palette ...Formatter) Rule {
+	if len(palette) == 0 {
+		palette = defaultHeatmapPalette()
+	}
+
+	return func(c CellContext) Formatter {
+		if c.Col != col {
+			return nil
+		}
+
+		n, ok := c.Number()
+		if !ok {
+			return nil
+		}
+
+		low, high, _ := c.ColumnRange()
+		if high <= low {
+			return palette[0]
+		}
+
+		ratio := math.Max(0, math.Min(1, (n-low)/(high-low)))
+
+		return palette[int(math.Round(ratio*float64(len(palette)-1)))]
+	}
+}
+
+// defaultHeatmapPalette yields a gradient of background colors from green to red, through yellow,
+// from the 256-colors terminal palette.
+func defaultHeatmapPalette() []Formatter {
+	const levels = 5 // color cube levels range from 0 to 5
+
+	colorIndex := func(r, g int) aurora.ColorIndex {
+		return aurora.ColorIndex(16 + 36*r + 6*g)
+	}
+
+	indices := make([]aurora.ColorIndex, 0, 2*levels+1)
+	for r := 0; r <= levels; r++ {
+		indices = append(indices, colorIndex(r, levels))
+	}
+
+	for g := levels - 1; g >= 0; g-- {
+		indices = append(indices, colorIndex(levels, g))
+	}
+
+	palette := make([]Formatter, len(indices))
+	for i, index := range indices {
+		index := index
+		palette[i] = func(in interface{}) aurora.Value { return aurora.BgIndex(index, in).Black() }
+	}
+
+	return palette
+}
+
+// ruleFormatter yields the combined formatter from all the rules applying to a cell, if any.
+func (t *Table) ruleFormatter(row, col int) Formatter {
+	if len(t.rules) == 0 || row >= len(t.rows) {
+		return nil
+	}
+
+	values := t.rows[row]
+	var value string
+	if col < len(values) {
+		value = values[col]
+	}
+
+	ctx := CellContext{Value: value, Row: row, Col: col, Values: values, table: t}
+
+	var formatters []Formatter
+	for _, rule := range t.rules {
+		if formatter := rule(ctx); formatter != nil {
+			formatters = append(formatters, formatter)
+		}
+	}
+
+	return chainFormatters(formatters...)
+}
+
+// columnRange determines the range of numeric values in a column. Ranges are cached until the next rendering.
+func (t *Table) columnRange(col int) numericRange {
+	if r, ok := t.columnRanges[col]; ok {
+		return r
+	}
+
+	r := numericRange{min: math.Inf(1), max: math.Inf(-1)}
+	for _, row := range t.rows {
+		if col >= len(row) {
+			continue
+		}
+
+		if n, ok := parseNumber(row[col]); ok {
+			r.min = math.Min(r.min, n)
+			r.max = math.Max(r.max, n)
+			r.ok = true
+		}
+	}
+
+	if !r.ok {
+		r.min, r.max = 0, 0
+	}
+
+	if t.columnRanges == nil {
+		t.columnRanges = make(map[int]numericRange)
+	}
+	t.columnRanges[col] = r
+
+	return r
+}
+
+// parseNumber parses a numeric value, ignoring thousands separators, leading currency symbols
+// and trailing percent signs.
+func parseNumber(value string) (float64, bool) {
+	value = strings.TrimSpace(value)
+	value = strings.TrimSuffix(value, "%")
+	value = strings.TrimLeftFunc(value, unicode.IsSymbol)
+	value = strings.Map(func(r rune) rune {
+		if r == ',' || r == '_' || unicode.IsSpace(r) {
+			return -1
+		}
+
+		return r
+	}, value)
+
+	if len(value) == 0 {
+		return 0, false
+	}
+
+	n, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsNaN(n) || math.IsInf(n, 0) {
+		return 0, false
+	}
+
+	return n, true
+}
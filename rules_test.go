@@ -0,0 +1,112 @@
+package tablewriter
+
+import (
+	"testing"
+
+	"github.com/logrusorgru/aurora/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRules(t *testing.T) {
+	t.Parallel()
+
+	red := func(in interface{}) aurora.Value { return aurora.Red(in) }
+	green := func(in interface{}) aurora.Value { return aurora.Green(in) }
+	bold := func(in interface{}) aurora.Value { return aurora.Bold(in) }
+
+	options := []Option{
+		WithHeader([]string{"Test", "Status", "Score"}),
+		WithRows([][]string{
+			{"alpha", "PASSED", "95"},
+			{"beta", "FAILED", "5%"},
+			{"gamma", "PASSED", "1,050"},
+		}),
+	}
+
+	t.Run("should format cells and rows with rules, retaining numeric alignment", func(t *testing.T) {
+		table, buf := NewBuffered(append(options,
+			WithRules(
+				When(2, Above(90), red),
+				When(2, Below(10), green),
+				WhenRow(1, Equals("FAILED"), bold),
+			),
+		)...)
+		require.NoError(t, table.Render())
+
+		const want = "+-------+--------+-------+\n" +
+			"| TEST  | STATUS | SCORE |\n" +
+			"+-------+--------+-------+\n" +
+			"| alpha | PASSED | \x1b[31m   95\x1b[0m |\n" +
+			"| \x1b[1mbeta \x1b[0m | \x1b[1mFAILED\x1b[0m | \x1b[1;32m   5%\x1b[0m |\n" +
+			"| gamma | PASSED | \x1b[31m1,050\x1b[0m |\n" +
+			"+-------+--------+-------+\n"
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should take precedence over column formatters, but not over individual cells", func(t *testing.T) {
+		table, _ := NewBuffered(append(options,
+			WithColFormatters(map[int]Formatter{0: green, 2: green}),
+			WithRules(When(-1, Equals("gamma"), red)),
+		)...)
+		table.AppendRich([]Cell{{Value: "gamma", Formatter: bold}})
+
+		require.Nil(t, table.ruleFormatter(0, 0))
+		require.Equal(t, "\x1b[32malpha\x1b[0m", format("alpha", table.cellFormatter(0, 0)))
+		require.Equal(t, "\x1b[31mgamma\x1b[0m", format("gamma", table.cellFormatter(2, 0)))
+		require.Equal(t, "\x1b[1mgamma\x1b[0m", format("gamma", table.cellFormatter(3, 0)))
+	})
+
+	t.Run("should ignore row rules on columns outside the row", func(t *testing.T) {
+		table, _ := NewBuffered(append(options,
+			WithRules(
+				WhenRow(-1, Equals("FAILED"), bold),
+				WhenRow(3, Equals("FAILED"), bold),
+			),
+		)...)
+
+		require.NotPanics(t, func() {
+			require.NoError(t, table.Render())
+		})
+
+		for row := range table.rows {
+			require.Nil(t, table.ruleFormatter(row, 0))
+		}
+	})
+
+	t.Run("should format numeric values on a scale", func(t *testing.T) {
+		table, _ := NewBuffered(append(options,
+			WithRules(Thresholds(2, []float64{10, 100}, green, nil, red)),
+		)...)
+
+		require.Nil(t, table.cellFormatter(0, 2))
+		require.Equal(t, "\x1b[32mx\x1b[0m", format("x", table.cellFormatter(1, 2)))
+		require.Equal(t, "\x1b[31mx\x1b[0m", format("x", table.cellFormatter(2, 2)))
+	})
+
+	t.Run("should format numeric values with a heatmap", func(t *testing.T) {
+		table, _ := NewBuffered(append(options, WithRules(Heatmap(2)))...)
+		require.NoError(t, table.Render())
+
+		palette := defaultHeatmapPalette()
+		require.Equal(t, format("x", palette[0]), format("x", table.cellFormatter(1, 2)))
+		require.Equal(t, format("x", palette[1]), format("x", table.cellFormatter(0, 2)))
+		require.Equal(t, format("x", palette[len(palette)-1]), format("x", table.cellFormatter(2, 2)))
+		require.Nil(t, table.cellFormatter(0, 1))
+		require.Equal(t, "\x1b[30;48;5;46mx\x1b[0m", format("x", palette[0]))
+	})
+
+	t.Run("should parse numbers", func(t *testing.T) {
+		for value, expected := range map[string]float64{
+			"12": 12, " -1.5 ": -1.5, "1,234.5": 1234.5, "45%": 45, "$12": 12, "1e3": 1000,
+		} {
+			n, ok := parseNumber(value)
+			require.Truef(t, ok, "expected %q to parse", value)
+			require.InDelta(t, expected, n, 1e-9)
+		}
+
+		for _, value := range []string{"", "abc", "12abc", "NaN", "-"} {
+			_, ok := parseNumber(value)
+			require.Falsef(t, ok, "expected %q not to parse", value)
+		}
+	})
+}
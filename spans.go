@@ -0,0 +1,244 @@
+package tablewriter
+
+import (
+	"sort"
+
+	wrap "github.com/fredbi/tablewriter/tablewrappers"
+)
+
+type (
+	// cellPos is the position of a cell in the rows of the table.
+	cellPos struct {
+		row, col int
+	}
+
+	// cellSpan is a cell spanning several rows and/or columns.
+	cellSpan struct {
+		cellPos
+		rows, cols int
+	}
+)
+
+// coveredColumns yields the columns of a new row which are covered by cells spanning several rows above.
+func (t *Table) coveredColumns(row int) map[int]bool {
+	var covered map[int]bool
+
+	for _, span := range t.rowSpans {
+		if row <= span.row || row >= span.row+span.rows {
+			continue
+		}
+
+		if covered == nil {
+			covered = make(map[int]bool)
+		}
+
+		for col := span.col; col < span.col+span.cols; col++ {
+			covered[col] = true
+		}
+	}
+
+	return covered
+}
+
+// rowWidth yields the number of columns of a row.
+//
+// The columns padded after a cell spanning several columns are not accounted for: spans do not widen the table.
+func (t *Table) rowWidth(row int) int {
+	cells := t.rowCells(row)
+	if len(cells) == 0 {
+		return len(t.rows[row])
+	}
+
+	width := 0
+	for col := 0; col < len(cells); col++ {
+		width = col + 1
+		if cells[col].ColSpan > 1 {
+			col += cells[col].ColSpan - 1
+		}
+	}
+
+	return width
+}
+
+// prepareSpans resolves the cells spanning several rows or columns, within the limits of the table.
+func (t *Table) prepareSpans() {
+	t.spans = nil
+	t.spanOrigins = nil
+
+	for row, cells := range t.richCells {
+		if row >= len(t.rows) {
+			continue
+		}
+
+		for col, cell := range cells {
+			if col >= t.numColumns {
+				// padded after a cell spanning beyond the columns of the table
+				break
+			}
+
+			rows := min(max(cell.RowSpan, 1), len(t.rows)-row)
+			cols := min(max(cell.ColSpan, 1), t.numColumns-col)
+			if rows < 2 && cols < 2 {
+				continue
+			}
+
+			t.spans = append(t.spans, cellSpan{cellPos: cellPos{row: row, col: col}, rows: rows, cols: cols})
+		}
+	}
+
+	if len(t.spans) == 0 {
+		return
+	}
+
+	sort.Slice(t.spans, func(i, j int) bool {
+		if t.spans[i].row == t.spans[j].row {
+			return t.spans[i].col < t.spans[j].col
+		}
+
+		return t.spans[i].row < t.spans[j].row
+	})
+
+	t.spanOrigins = make(map[cellPos]cellSpan)
+	for _, span := range t.spans {
+		for row := span.row; row < span.row+span.rows; row++ {
+			for col := span.col; col < span.col+span.cols; col++ {
+				pos := cellPos{row: row, col: col}
+				if _, isCovered := t.spanOrigins[pos]; isCovered {
+					// overlapping spans: the first one wins
+					continue
+				}
+
+				t.spanOrigins[pos] = span
+			}
+		}
+	}
+}
+
+// spanAt yields the span covering a cell, if any.
+func (t *Table) spanAt(row, col int) (cellSpan, bool) {
+	span, ok := t.spanOrigins[cellPos{row: row, col: col}]
+
+	return span, ok
+}
+
+// spanOrigin yields the position of the cell which holds the content of a cell covered by a span.
+func (t *Table) spanOrigin(row, col int) (int, int) {
+	if span, ok := t.spanAt(row, col); ok {
+		return span.row, span.col
+	}
+
+	return row, col
+}
+
+// colSpan yields the number of columns occupied by a cell on a row.
+//
+// Cells hidden by a span on their left yield 0.
+func (t *Table) colSpan(row, col int) int {
+	span, ok := t.spanAt(row, col)
+	switch {
+	case !ok:
+		return 1
+	case span.col != col:
+		return 0
+	default:
+		return span.cols
+	}
+}
+
+// isJoined tells if the columns on both sides of the right boundary of column col belong to the same span on this row.
+func (t *Table) isJoined(row, col int) bool {
+	span, ok := t.spanAt(row, col)
+
+	return ok && col+1 < span.col+span.cols
+}
+
+// isContinued tells if a cell continues on the next row, as part of a span.
+func (t *Table) isContinued(row, col int) bool {
+	span, ok := t.spanAt(row, col)
+
+	return ok && row+1 < span.row+span.rows
+}
+
+// spanWidth yields the width available to the content of a cell spanning several columns.
+func (t *Table) spanWidth(col, cols int) int {
+	width := 0
+	for i := col; i < col+cols; i++ {
+		width += t.colWidth[i]
+	}
+
+	paddingWidth := wrap.DisplayWidth(t.tablePadding)
+	if t.noWhiteSpace {
+		return width + paddingWidth*(cols-1)
+	}
+
+	return width + (2*paddingWidth+wrap.DisplayWidth(t.style.Vertical))*(cols-1)
+}
+
+// layoutSpans distributes the content of spanning cells.
+//
+// The width of a cell spanning several columns is spread over its columns, and the lines of a cell spanning
+// several rows are spread over its rows.
+func (t *Table) layoutSpans() {
+	// widths of cells spanning a single column are settled first
+	for _, span := range t.spans {
+		if span.cols < 2 {
+			t.setColWidth(span.col, wrap.CellWidth(t.lines[span.row][span.col]))
+		}
+	}
+
+	for _, span := range t.spans {
+		if span.cols < 2 {
+			continue
+		}
+
+		t.spreadWidth(span.col, span.cols, wrap.CellWidth(t.lines[span.row][span.col]))
+	}
+
+	// heights of cells spanning a single row are settled first
+	for _, span := range t.spans {
+		if span.rows < 2 {
+			t.setRowHeight(span.row, len(t.lines[span.row][span.col]))
+		}
+	}
+
+	for _, span := range t.spans {
+		if span.rows < 2 {
+			continue
+		}
+
+		lines := t.lines[span.row][span.col]
+
+		lastRow := span.row + span.rows - 1
+		available := 0
+		for row := span.row; row <= lastRow; row++ {
+			available += t.rowMaxHeight[row]
+		}
+
+		if missing := len(lines) - available; missing > 0 {
+			t.rowMaxHeight[lastRow] += missing
+			available += missing
+		}
+
+		// the content is aligned vertically over all the rows of the span
+		lines = valignLines(lines, available, t.cellVAlignment(span.row, span.col))
+
+		for row := span.row; row <= lastRow; row++ {
+			height := min(t.rowMaxHeight[row], len(lines))
+			t.lines[row][span.col] = lines[:height]
+			lines = lines[height:]
+		}
+	}
+}
+
+// spreadWidth widens evenly the columns spanned by a cell, whenever its content is wider than these columns.
+func (t *Table) spreadWidth(col, cols, width int) {
+	missing := width - t.spanWidth(col, cols)
+	for i := 0; i < cols && missing > 0; i++ {
+		extra := missing / cols
+		if i < missing%cols {
+			extra++
+		}
+
+		t.colWidth[col+i] += extra
+	}
+}
@@ -0,0 +1,145 @@
+package tablewriter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpans(t *testing.T) {
+	t.Parallel()
+
+	appendQuarter := func(table *Table) {
+		table.AppendRich([]Cell{{Value: ""}, {Value: "Q1", ColSpan: 3, Align: AlignCenter}})
+		table.AppendRich([]Cell{{Value: "Europe", RowSpan: 2}, {Value: "1"}, {Value: "2"}, {Value: "3"}})
+		table.Append([]string{"4", "5", "6"}) // the first column is covered by "Europe"
+		table.AppendRich([]Cell{{Value: "Other regions, all included", ColSpan: 4}})
+		table.Append([]string{"Asia", "7", "8", "9"})
+	}
+
+	t.Run("should omit cells covered by spans from the rows", func(t *testing.T) {
+		table, _ := NewBuffered()
+		appendQuarter(table)
+
+		require.Equal(t, [][]string{
+			{"", "Q1", "", ""},
+			{"Europe", "1", "2", "3"},
+			{"", "4", "5", "6"},
+			{"Other regions, all included", "", "", ""},
+			{"Asia", "7", "8", "9"},
+		}, table.Rows())
+	})
+
+	t.Run("should render spans and omit junctions under spans", func(t *testing.T) {
+		table, buf := NewBuffered(
+			WithHeader([]string{"Region", "Jan", "Feb", "Mar"}),
+			WithRowLine(true),
+			WithStyle(StyleLight),
+		)
+		appendQuarter(table)
+		require.NoError(t, table.Render())
+
+		const want = `┌─────────┬──────┬──────┬─────┐
+│ REGION  │ JAN  │ FEB  │ MAR │
+├─────────┼──────┴──────┴─────┤
+│         │        Q1         │
+├─────────┼──────┬──────┬─────┤
+│ Europe  │    1 │    2 │   3 │
+│         ├──────┼──────┼─────┤
+│         │    4 │    5 │   6 │
+├─────────┴──────┴──────┴─────┤
+│ Other regions, all included │
+├─────────┬──────┬──────┬─────┤
+│ Asia    │    7 │    8 │   9 │
+└─────────┴──────┴──────┴─────┘
+`
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should spread the lines of a cell over the rows it spans", func(t *testing.T) {
+		table, buf := NewBuffered(WithHeader([]string{"Group", "Item"}), WithWrap(false))
+		table.AppendRich([]Cell{{Value: "fruits\nand\nvegetables", RowSpan: 2}, {Value: "apple"}})
+		table.Append([]string{"carrot"})
+		require.NoError(t, table.Render())
+
+		const want = `+------------+--------+
+|   GROUP    |  ITEM  |
++------------+--------+
+| fruits     | apple  |
+| and        | carrot |
+| vegetables |        |
++------------+--------+
+`
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should settle the height of rows made of spans before spreading lines", func(t *testing.T) {
+		table, buf := NewBuffered(WithHeader([]string{"A", "B", "C"}))
+		table.AppendRich([]Cell{{Value: "x", RowSpan: 2}, {Value: "Q1", ColSpan: 2}})
+		table.Append([]string{"b", "c"})
+		require.NoError(t, table.Render())
+
+		const want = `+---+---+---+
+| A | B | C |
++---+---+---+
+| x | Q1    |
+|   | b | c |
++---+---+---+
+`
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should render spans in HTML", func(t *testing.T) {
+		table, buf := NewBuffered(WithHeader([]string{"Region", "Jan", "Feb", "Mar"}), WithFormat(FormatHTML))
+		appendQuarter(table)
+		require.NoError(t, table.Render())
+
+		const want = `<table>
+  <thead>
+    <tr><th style="text-align: center">REGION</th><th style="text-align: center">JAN</th><th style="text-align: center">FEB</th><th style="text-align: center">MAR</th></tr>
+  </thead>
+  <tbody>
+    <tr><td></td><td colspan="3" style="text-align: center">Q1</td></tr>
+    <tr><td rowspan="2">Europe</td><td style="text-align: right">1</td><td style="text-align: right">2</td><td style="text-align: right">3</td></tr>
+    <tr><td style="text-align: right">4</td><td style="text-align: right">5</td><td style="text-align: right">6</td></tr>
+    <tr><td colspan="4">Other regions, all included</td></tr>
+    <tr><td>Asia</td><td style="text-align: right">7</td><td style="text-align: right">8</td><td style="text-align: right">9</td></tr>
+  </tbody>
+</table>
+`
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should clip spans to the columns of the table", func(t *testing.T) {
+		table, buf := NewBuffered(WithHeader([]string{"A", "B"}))
+		table.AppendRich([]Cell{{Value: "wide", ColSpan: 5}})
+		table.AppendRich([]Cell{{Value: "x"}, {Value: "y", ColSpan: 3}})
+		require.NoError(t, table.Render())
+
+		const want = `+---+---+
+| A | B |
++---+---+
+| wide  |
+| x | y |
++---+---+
+`
+		checkEqual(t, buf.String(), want)
+		require.Equal(t, 2, table.numColumns)
+	})
+
+	t.Run("should clip spans to the last row", func(t *testing.T) {
+		table, buf := NewBuffered(WithHeader([]string{"A", "B"}))
+		table.AppendRich([]Cell{{Value: "x", RowSpan: 3}, {Value: "y"}})
+		table.Append([]string{"z"})
+		require.NoError(t, table.Render())
+
+		const want = `+---+---+
+| A | B |
++---+---+
+| x | y |
+|   | z |
++---+---+
+`
+		checkEqual(t, buf.String(), want)
+	})
+}
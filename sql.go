@@ -0,0 +1,146 @@
+package tablewriter
+
+import (
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// database type names of numeric columns, as reported by common drivers
+var sqlNumericTypes = map[string]bool{
+	"INT": true, "INTEGER": true, "TINYINT": true, "SMALLINT": true, "MEDIUMINT": true, "BIGINT": true,
+	"INT2": true, "INT4": true, "INT8": true,
+	"UNSIGNED INT": true, "UNSIGNED TINYINT": true, "UNSIGNED SMALLINT": true,
+	"UNSIGNED MEDIUMINT": true, "UNSIGNED BIGINT": true,
+	"SERIAL": true, "BIGSERIAL": true, "SMALLSERIAL": true,
+	"DECIMAL": true, "NUMERIC": true, "NUMBER": true, "MONEY": true,
+	"FLOAT": true, "FLOAT4": true, "FLOAT8": true, "DOUBLE": true, "DOUBLE PRECISION": true, "REAL": true,
+}
+
+// NewFromSQLRows builds a Table writer that reads its rows from the result of a database query.
+//
+// The header is made of the column names. Values are rendered as follows:
+//   - NULL values are rendered with a placeholder (see WithSQLNullPlaceholder)
+//   - time.Time values are formatted with a layout (see WithSQLTimeLayout)
+//   - []byte values are rendered as strings, or as hexadecimal when they are not valid UTF-8
+//
+// Columns with a numeric type are right-aligned, and other columns are left-aligned, unless an
+// alignment is specified for the column (see WithColAlignment) or for all cells (see WithCellAlignment).
+//
+// The rows are consumed but not closed.
+func NewFromSQLRows(rows *sql.Rows, opts ...Option) (*Table, error) {
+	table := New(opts...)
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, fmt.Errorf("sql: reading columns: %w", err)
+	}
+
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, fmt.Errorf("sql: reading column types: %w", err)
+	}
+
+	table.header = columns
+	table.alignSQLColumns(columnTypes)
+
+	values := make([]interface{}, len(columns))
+	targets := make([]interface{}, len(columns))
+	for i := range values {
+		targets[i] = &values[i]
+	}
+
+	for count := 1; rows.Next(); count++ {
+		if err := rows.Scan(targets...); err != nil {
+			return nil, fmt.Errorf("sql: scanning row #%d: %w", count, err)
+		}
+
+		row := make([]string, len(values))
+		for i, value := range values {
+			row[i] = table.sqlValue(value)
+		}
+
+		table.Append(row)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("sql: reading rows: %w", err)
+	}
+
+	return table, nil
+}
+
+// alignSQLColumns aligns columns according to their type, unless some alignment is already specified.
+func (t *Table) alignSQLColumns(columnTypes []*sql.ColumnType) {
+	if t.cellAlign != AlignDefault {
+		return
+	}
+
+	perColumnAlign := make(map[int]HAlignment, len(columnTypes))
+	for col, columnType := range columnTypes {
+		perColumnAlign[col] = AlignLeft
+		if isSQLNumeric(columnType) {
+			perColumnAlign[col] = AlignRight
+		}
+	}
+
+	for col, align := range t.perColumnAlign {
+		perColumnAlign[col] = align
+	}
+
+	t.perColumnAlign = perColumnAlign
+}
+
+// isSQLNumeric determines if a column holds numbers, from its scan type or database type name.
+func isSQLNumeric(columnType *sql.ColumnType) bool {
+	if sqlNumericTypes[strings.ToUpper(columnType.DatabaseTypeName())] {
+		return true
+	}
+
+	scanType := columnType.ScanType()
+	if scanType == nil {
+		return false
+	}
+
+	switch scanType {
+	case reflect.TypeOf(sql.NullInt16{}), reflect.TypeOf(sql.NullInt32{}), reflect.TypeOf(sql.NullInt64{}),
+		reflect.TypeOf(sql.NullFloat64{}), reflect.TypeOf(sql.NullByte{}):
+		return true
+	}
+
+	switch scanType.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// sqlValue renders a value scanned from a database.
+func (t *Table) sqlValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return t.sqlNullPlaceholder
+	case time.Time:
+		return v.Format(t.sqlTimeLayout)
+	case []byte:
+		if utf8.Valid(v) {
+			return string(v)
+		}
+
+		return "0x" + hex.EncodeToString(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,33 @@
+package tablewriter
+
+import "time"
+
+type sqlOptions struct {
+	sqlNullPlaceholder string
+	sqlTimeLayout      string
+}
+
+func defaultSQLOptions() sqlOptions {
+	return sqlOptions{
+		sqlNullPlaceholder: "NULL",
+		sqlTimeLayout:      time.RFC3339,
+	}
+}
+
+// WithSQLNullPlaceholder defines the placeholder rendered for NULL values, when reading sql.Rows.
+//
+// The default is "NULL".
+func WithSQLNullPlaceholder(placeholder string) Option {
+	return func(o *options) {
+		o.sqlNullPlaceholder = placeholder
+	}
+}
+
+// WithSQLTimeLayout defines the layout used to format time.Time values, when reading sql.Rows.
+//
+// The default is time.RFC3339.
+func WithSQLTimeLayout(layout string) Option {
+	return func(o *options) {
+		o.sqlTimeLayout = layout
+	}
+}
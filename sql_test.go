@@ -0,0 +1,173 @@
+package tablewriter
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const stubDriverName = "tablewriter-stub"
+
+var (
+	registerStubDriver sync.Once
+
+	// datasets served by the stub driver, by data source name
+	stubDatasets = map[string]stubDataset{
+		"films": {
+			columns: []string{"id", "title", "rating", "released", "poster", "code"},
+			types:   []string{"INTEGER", "VARCHAR", "", "TIMESTAMP", "BLOB", "VARCHAR"},
+			scanTypes: []reflect.Type{
+				reflect.TypeOf(int64(0)), reflect.TypeOf(""), reflect.TypeOf(float64(0)),
+				reflect.TypeOf(time.Time{}), reflect.TypeOf([]byte{}), reflect.TypeOf(""),
+			},
+			rows: [][]driver.Value{
+				{int64(1), "The Good", 8.75, time.Date(1966, 12, 23, 0, 0, 0, 0, time.UTC), []byte("poster"), "007"},
+				{int64(22), "The Bad", nil, nil, []byte{0xff, 0x01}, "12"},
+				{int64(333), nil, 1e6, time.Date(1967, 12, 29, 0, 0, 0, 0, time.UTC), nil, "x"},
+			},
+		},
+		"failing": {
+			columns:   []string{"id"},
+			types:     []string{"INTEGER"},
+			scanTypes: []reflect.Type{reflect.TypeOf(int64(0))},
+			rows:      [][]driver.Value{{int64(1)}},
+			err:       errors.New("connection lost"),
+		},
+	}
+)
+
+type (
+	stubDataset struct {
+		columns   []string
+		types     []string
+		scanTypes []reflect.Type
+		rows      [][]driver.Value
+		err       error
+	}
+
+	stubDriver struct{}
+
+	stubConn struct {
+		dataset stubDataset
+	}
+
+	stubStmt struct {
+		dataset stubDataset
+	}
+
+	stubRows struct {
+		stubDataset
+		current int
+	}
+)
+
+func (stubDriver) Open(name string) (driver.Conn, error) {
+	dataset, ok := stubDatasets[name]
+	if !ok {
+		return nil, errors.New("unknown dataset")
+	}
+
+	return &stubConn{dataset: dataset}, nil
+}
+
+func (c *stubConn) Prepare(string) (driver.Stmt, error) { return &stubStmt{dataset: c.dataset}, nil }
+func (c *stubConn) Close() error                        { return nil }
+func (c *stubConn) Begin() (driver.Tx, error)           { return nil, errors.New("not supported") }
+
+func (s *stubStmt) Close() error  { return nil }
+func (s *stubStmt) NumInput() int { return 0 }
+func (s *stubStmt) Exec([]driver.Value) (driver.Result, error) {
+	return nil, errors.New("not supported")
+}
+func (s *stubStmt) Query([]driver.Value) (driver.Rows, error) {
+	return &stubRows{stubDataset: s.dataset}, nil
+}
+
+func (r *stubRows) Columns() []string                           { return r.columns }
+func (r *stubRows) Close() error                                { return nil }
+func (r *stubRows) ColumnTypeDatabaseTypeName(index int) string { return r.types[index] }
+func (r *stubRows) ColumnTypeScanType(index int) reflect.Type   { return r.scanTypes[index] }
+func (r *stubRows) Next(dest []driver.Value) error {
+	if r.current >= len(r.rows) {
+		if r.err != nil {
+			return r.err
+		}
+
+		return io.EOF
+	}
+
+	copy(dest, r.rows[r.current])
+	r.current++
+
+	return nil
+}
+
+func queryStub(t *testing.T, dataset string) *sql.Rows {
+	t.Helper()
+
+	registerStubDriver.Do(func() {
+		sql.Register(stubDriverName, stubDriver{})
+	})
+
+	db, err := sql.Open(stubDriverName, dataset)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	rows, err := db.Query("SELECT * FROM stub")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = rows.Close()
+	})
+
+	return rows
+}
+
+func TestSQLRows(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should render query results", func(t *testing.T) {
+		var buf bytes.Buffer
+		table, err := NewFromSQLRows(queryStub(t, "films"), WithWriter(&buf), WithSQLTimeLayout("2006-01-02"))
+		require.NoError(t, err)
+		require.NoError(t, table.Render())
+
+		const want = `+-----+----------+---------+------------+--------+------+
+| ID  |  TITLE   | RATING  |  RELEASED  | POSTER | CODE |
++-----+----------+---------+------------+--------+------+
+|   1 | The Good |    8.75 | 1966-12-23 | poster | 007  |
+|  22 | The Bad  |    NULL | NULL       | 0xff01 | 12   |
+| 333 | NULL     | 1000000 | 1967-12-29 | NULL   | x    |
++-----+----------+---------+------------+--------+------+
+`
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should render NULL with a placeholder and retain explicit alignments", func(t *testing.T) {
+		table, err := NewFromSQLRows(queryStub(t, "films"),
+			WithSQLNullPlaceholder("-"),
+			WithColAlignment(map[int]HAlignment{0: AlignCenter}),
+		)
+		require.NoError(t, err)
+
+		require.Equal(t, []string{"22", "The Bad", "-", "-", "0xff01", "12"}, table.rows[1])
+		require.Equal(t, AlignCenter, table.perColumnAlign[0])
+		require.Equal(t, AlignRight, table.perColumnAlign[2])
+		require.Equal(t, AlignLeft, table.perColumnAlign[5])
+	})
+
+	t.Run("should report errors", func(t *testing.T) {
+		_, err := NewFromSQLRows(queryStub(t, "failing"))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "sql: reading rows: connection lost")
+	})
+}
@@ -0,0 +1,46 @@
+package tablewriter
+
+const defaultStructTag = "tablewriter"
+
+type structOptions struct {
+	structTag     string
+	structColumns []string
+	structFlatten bool
+}
+
+func defaultStructOptions() structOptions {
+	return structOptions{
+		structTag: defaultStructTag,
+	}
+}
+
+// WithStructTag defines the key of the struct tag used by SetStructs and FromSlice, e.g. "json".
+//
+// The default is "tablewriter".
+func WithStructTag(key string) Option {
+	return func(o *options) {
+		o.structTag = key
+	}
+}
+
+// WithStructColumns selects and orders the columns to retain by their heading, when using SetStructs or FromSlice.
+//
+// By default, all fields are retained in their declaration order.
+// For slices of maps, columns are selected by key and all keys are retained in sorted order by default.
+func WithStructColumns(names ...string) Option {
+	return func(o *options) {
+		o.structColumns = names
+	}
+}
+
+// WithStructFlatten flattens nested struct fields into several columns, with headings like "Address.City",
+// when using SetStructs or FromSlice.
+//
+// Embedded structs are always flattened. Structs implementing fmt.Stringer (e.g. time.Time) are not flattened.
+//
+// The default is disabled: nested structs are rendered in a single column.
+func WithStructFlatten(enabled bool) Option {
+	return func(o *options) {
+		o.structFlatten = enabled
+	}
+}
@@ -0,0 +1,159 @@
+package tablewriter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// record is a row of the table, as an ordered set of key-value pairs.
+type record struct {
+	keys   []string
+	values []string
+}
+
+// MarshalJSON renders a record as a JSON object, preserving the order of the columns.
+func (r record) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.WriteByte('{')
+	for i, key := range r.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		k, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := json.Marshal(r.values[i])
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(k)
+		buf.WriteByte(':')
+		buf.Write(v)
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+// yamlNode renders a record as a YAML mapping, preserving the order of the columns.
+func (r record) yamlNode() *yaml.Node {
+	node := &yaml.Node{Kind: yaml.MappingNode}
+
+	for i, key := range r.keys {
+		node.Content = append(node.Content,
+			&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key},
+			&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: r.values[i]},
+		)
+	}
+
+	return node
+}
+
+// records yields the rows of the table as records keyed by header.
+//
+// The rows of the footer, if any, are rendered as last records.
+func (t *Table) records() []record {
+	keys := t.recordKeys()
+	records := make([]record, 0, len(t.rows)+len(t.footerValues))
+
+	for _, row := range t.rowValues {
+		records = append(records, record{keys: keys, values: row})
+	}
+
+	titler := t.headerPrepadder()
+	for _, footer := range t.footerValues {
+		values := make([]string, t.numColumns)
+		for col := range values {
+			if value := footer[col]; len(value) > 0 {
+				values[col] = strings.TrimSpace(titler(value))
+			}
+		}
+
+		records = append(records, record{keys: keys, values: values})
+	}
+
+	return records
+}
+
+// recordKeys determines the keys of records from the header, with the same titler as the text renderer.
+//
+// Columns without a heading are keyed by their index. Duplicate keys are suffixed by their index, or by the next
+// index available when the suffixed key is already taken.
+func (t *Table) recordKeys() []string {
+	titler := t.headerPrepadder()
+	keys := make([]string, t.numColumns)
+	seen := make(map[string]bool, t.numColumns)
+
+	for col := range keys {
+		var key string
+		if col < len(t.headerValues) {
+			key = strings.TrimSpace(titler(t.headerValues[col]))
+		}
+
+		if len(key) == 0 {
+			key = strconv.Itoa(col)
+		}
+
+		for base, suffix := key, col; seen[key]; suffix++ {
+			key = fmt.Sprintf("%s_%d", base, suffix)
+		}
+
+		seen[key] = true
+		keys[col] = key
+	}
+
+	return keys
+}
+
+// renderJSON renders the table as a JSON array of objects.
+func (t *Table) renderJSON() error {
+	buf, err := json.MarshalIndent(t.records(), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprint(t.out, string(buf), t.newLine)
+
+	return nil
+}
+
+// renderNDJSON renders the table as newline-delimited JSON objects, one per row.
+func (t *Table) renderNDJSON() error {
+	for _, rec := range t.records() {
+		buf, err := json.Marshal(rec)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprint(t.out, string(buf), t.newLine)
+	}
+
+	return nil
+}
+
+// renderYAML renders the table as a YAML sequence of mappings.
+func (t *Table) renderYAML() error {
+	doc := &yaml.Node{Kind: yaml.SequenceNode}
+	for _, rec := range t.records() {
+		doc.Content = append(doc.Content, rec.yamlNode())
+	}
+
+	enc := yaml.NewEncoder(t.out)
+	enc.SetIndent(2)
+
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+
+	return enc.Close()
+}
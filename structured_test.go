@@ -0,0 +1,118 @@
+package tablewriter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStructured(t *testing.T) {
+	t.Parallel()
+
+	options := []Option{
+		WithHeader([]string{"first_name", "Last Name", ""}),
+		WithRows([][]string{
+			{"John", "Barry", "123456"},
+			{"Kathy", "Smith \"K\"", "687987", "extra"},
+		}),
+		WithFooter([]string{"", "total", "811443"}),
+	}
+
+	t.Run("should render JSON", func(t *testing.T) {
+		table, buf := NewBuffered(append(options, WithFormat(FormatJSON))...)
+		require.NoError(t, table.Render())
+
+		const want = `[
+  {
+    "FIRST NAME": "John",
+    "LAST NAME": "Barry",
+    "2": "123456",
+    "3": ""
+  },
+  {
+    "FIRST NAME": "Kathy",
+    "LAST NAME": "Smith \"K\"",
+    "2": "687987",
+    "3": "extra"
+  },
+  {
+    "FIRST NAME": "",
+    "LAST NAME": "TOTAL",
+    "2": "811443",
+    "3": ""
+  }
+]
+`
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should render NDJSON", func(t *testing.T) {
+		table, buf := NewBuffered(append(options, WithFormat(FormatNDJSON), WithTitledHeader(false))...)
+		require.NoError(t, table.Render())
+
+		const want = `{"first_name":"John","Last Name":"Barry","2":"123456","3":""}
+{"first_name":"Kathy","Last Name":"Smith \"K\"","2":"687987","3":"extra"}
+{"first_name":"","Last Name":"total","2":"811443","3":""}
+`
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should render YAML", func(t *testing.T) {
+		table, buf := NewBuffered(
+			WithHeader([]string{"Name", "Name", "Rating"}),
+			WithRows([][]string{
+				{"A", "The Good", "500"},
+				{"B", "The Very\nBad Man", "288"},
+			}),
+			WithFormat(FormatYAML),
+		)
+		require.NoError(t, table.Render())
+
+		const want = `- NAME: A
+  NAME_1: The Good
+  RATING: "500"
+- NAME: B
+  NAME_1: |-
+    The Very
+    Bad Man
+  RATING: "288"
+`
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should suffix duplicate keys until they are unique", func(t *testing.T) {
+		table, buf := NewBuffered(
+			WithHeader([]string{"key_2", "key", "key", "key"}),
+			WithRows([][]string{{"a", "b", "c", "d"}}),
+			WithFormat(FormatNDJSON),
+			WithTitledHeader(false),
+		)
+		require.NoError(t, table.Render())
+
+		checkEqual(t, buf.String(), `{"key_2":"a","key":"b","key_3":"c","key_4":"d"}`+"\n")
+	})
+
+	t.Run("should render an empty table", func(t *testing.T) {
+		table, buf := NewBuffered(WithFormat(FormatJSON))
+		require.NoError(t, table.Render())
+
+		checkEqual(t, buf.String(), "[]\n")
+	})
+}
+
+func TestParseFormat(t *testing.T) {
+	t.Parallel()
+
+	for _, format := range []Format{FormatText, FormatMarkdown, FormatHTML, FormatJSON, FormatNDJSON, FormatYAML} {
+		parsed, err := ParseFormat(format.String())
+		require.NoError(t, err)
+		require.Equal(t, format, parsed)
+	}
+
+	parsed, err := ParseFormat(" JSON ")
+	require.NoError(t, err)
+	require.Equal(t, FormatJSON, parsed)
+
+	_, err = ParseFormat("xml")
+	require.Error(t, err)
+}
@@ -0,0 +1,186 @@
+package tablewriter
+
+type (
+	// Style defines the glyphs used to draw the grid of a table.
+	//
+	// Junctions are picked according to their position on the grid: corners, tees and crosses
+	// may thus be represented with distinct glyphs, e.g. with unicode box-drawing characters.
+	//
+	// A few predefined styles are provided: StyleASCII, StyleLight, StyleHeavy, StyleDouble,
+	// StyleRounded and StyleDoubleHeader.
+	Style struct {
+		// junctions on the top border
+		TopLeft  string
+		TopMid   string
+		TopRight string
+
+		// junctions on separator lines between rows, and above the footer
+		MidLeft  string
+		Cross    string
+		MidRight string
+
+		// junctions on the bottom border
+		BottomLeft  string
+		BottomMid   string
+		BottomRight string
+
+		// separator line between the header and the rows
+		HeaderLeft       string
+		HeaderCross      string
+		HeaderRight      string
+		HeaderHorizontal string
+
+		// MidVertical is drawn on a separator line where a vertical line passes through,
+		// without any horizontal segment on its sides (e.g. when merging cells).
+		MidVertical string
+
+		Horizontal string
+		Vertical   string
+	}
+
+	// lineKind tells which horizontal line of the grid is being drawn.
+	lineKind uint8
+)
+
+const (
+	lineTop lineKind = iota
+	lineHeader
+	lineMiddle
+	lineBottom
+)
+
+// Predefined styles.
+var (
+	// StyleASCII draws the grid with '+', '-' and '|'. This is the default.
+	StyleASCII = Style{
+		TopLeft: CENTER, TopMid: CENTER, TopRight: CENTER,
+		MidLeft: CENTER, Cross: CENTER, MidRight: CENTER,
+		BottomLeft: CENTER, BottomMid: CENTER, BottomRight: CENTER,
+		HeaderLeft: CENTER, HeaderCross: CENTER, HeaderRight: CENTER, HeaderHorizontal: ROW,
+		MidVertical: CENTER,
+		Horizontal:  ROW,
+		Vertical:    COLUMN,
+	}
+
+	// StyleLight draws the grid with light box-drawing characters.
+	StyleLight = Style{
+		TopLeft: "┌", TopMid: "┬", TopRight: "┐",
+		MidLeft: "├", Cross: "┼", MidRight: "┤",
+		BottomLeft: "└", BottomMid: "┴", BottomRight: "┘",
+		HeaderLeft: "├", HeaderCross: "┼", HeaderRight: "┤", HeaderHorizontal: "─",
+		MidVertical: "│",
+		Horizontal:  "─",
+		Vertical:    "│",
+	}
+
+	// StyleHeavy draws the grid with heavy box-drawing characters.
+	StyleHeavy = Style{
+		TopLeft: "┏", TopMid: "┳", TopRight: "┓",
+		MidLeft: "┣", Cross: "╋", MidRight: "┫",
+		BottomLeft: "┗", BottomMid: "┻", BottomRight: "┛",
+		HeaderLeft: "┣", HeaderCross: "╋", HeaderRight: "┫", HeaderHorizontal: "━",
+		MidVertical: "┃",
+		Horizontal:  "━",
+		Vertical:    "┃",
+	}
+
+	// StyleDouble draws the grid with double box-drawing characters.
+	StyleDouble = Style{
+		TopLeft: "╔", TopMid: "╦", TopRight: "╗",
+		MidLeft: "╠", Cross: "╬", MidRight: "╣",
+		BottomLeft: "╚", BottomMid: "╩", BottomRight: "╝",
+		HeaderLeft: "╠", HeaderCross: "╬", HeaderRight: "╣", HeaderHorizontal: "═",
+		MidVertical: "║",
+		Horizontal:  "═",
+		Vertical:    "║",
+	}
+
+	// StyleRounded draws the grid with light box-drawing characters and rounded corners.
+	StyleRounded = Style{
+		TopLeft: "╭", TopMid: "┬", TopRight: "╮",
+		MidLeft: "├", Cross: "┼", MidRight: "┤",
+		BottomLeft: "╰", BottomMid: "┴", BottomRight: "╯",
+		HeaderLeft: "├", HeaderCross: "┼", HeaderRight: "┤", HeaderHorizontal: "─",
+		MidVertical: "│",
+		Horizontal:  "─",
+		Vertical:    "│",
+	}
+
+	// StyleDoubleHeader draws the grid with light box-drawing characters,
+	// with a double separator line under the header.
+	StyleDoubleHeader = Style{
+		TopLeft: "┌", TopMid: "┬", TopRight: "┐",
+		MidLeft: "├", Cross: "┼", MidRight: "┤",
+		BottomLeft: "└", BottomMid: "┴", BottomRight: "┘",
+		HeaderLeft: "╞", HeaderCross: "╪", HeaderRight: "╡", HeaderHorizontal: "═",
+		MidVertical: "│",
+		Horizontal:  "─",
+		Vertical:    "│",
+	}
+)
+
+// WithStyle defines the glyphs used to draw the grid of the table.
+//
+// This overrides any previous setting by WithCenterSeparator, WithRowSeparator or WithColumnSeparator.
+//
+// The default is StyleASCII.
+func WithStyle(style Style) Option {
+	return func(o *options) {
+		o.style = style
+	}
+}
+
+// setJunctions sets all junctions to the same glyph.
+func (s *Style) setJunctions(sep string) {
+	s.TopLeft, s.TopMid, s.TopRight = sep, sep, sep
+	s.MidLeft, s.Cross, s.MidRight = sep, sep, sep
+	s.BottomLeft, s.BottomMid, s.BottomRight = sep, sep, sep
+	s.HeaderLeft, s.HeaderCross, s.HeaderRight = sep, sep, sep
+	s.MidVertical = sep
+}
+
+// horizontal yields the glyph used to draw segments of a line.
+func (s Style) horizontal(kind lineKind) string {
+	if kind == lineHeader {
+		return s.HeaderHorizontal
+	}
+
+	return s.Horizontal
+}
+
+// junction yields the glyph to draw at the intersection of a horizontal line with a column boundary,
+// knowing which branches of this intersection are drawn.
+func (s Style) junction(kind lineKind, up, down, left, right bool) string {
+	vertical := up || down
+	horizontal := left || right
+
+	switch {
+	case !vertical && !horizontal:
+		return SPACE
+	case !vertical:
+		return s.horizontal(kind)
+	case !horizontal:
+		return stringIf(up && down, s.MidVertical, s.Vertical)
+	}
+
+	switch {
+	case up && down && left && right:
+		return stringIf(kind == lineHeader, s.HeaderCross, s.Cross)
+	case up && down && right:
+		return stringIf(kind == lineHeader, s.HeaderLeft, s.MidLeft)
+	case up && down: // && left
+		return stringIf(kind == lineHeader, s.HeaderRight, s.MidRight)
+	case down && left && right:
+		return s.TopMid
+	case up && left && right:
+		return s.BottomMid
+	case down && right:
+		return s.TopLeft
+	case down: // && left
+		return s.TopRight
+	case right: // && up
+		return s.BottomLeft
+	default: // up && left
+		return s.BottomRight
+	}
+}
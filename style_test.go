@@ -0,0 +1,189 @@
+package tablewriter
+
+import (
+	"testing"
+
+	"github.com/logrusorgru/aurora/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStyle(t *testing.T) {
+	t.Parallel()
+
+	data := [][]string{
+		{"A", "The Good", "500"},
+		{"A", "The Very very Bad Man", "288"},
+		{"B", "The Ugly", "120"},
+	}
+
+	t.Run("should render with light box-drawing characters", func(t *testing.T) {
+		table, buf := NewBuffered(
+			WithHeader([]string{"Name", "Sign", "Rating"}),
+			WithFooter([]string{"", "Total", "908"}),
+			WithRows(data),
+			WithStyle(StyleLight),
+		)
+		table.Render()
+
+		const want = `┌──────┬───────────────────────┬────────┐
+│ NAME │         SIGN          │ RATING │
+├──────┼───────────────────────┼────────┤
+│ A    │ The Good              │    500 │
+│ A    │ The Very very Bad Man │    288 │
+│ B    │ The Ugly              │    120 │
+├──────┼───────────────────────┼────────┤
+│                TOTAL         │  908   │
+└──────┴───────────────────────┴────────┘
+`
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should render with a double header line and row lines", func(t *testing.T) {
+		table, buf := NewBuffered(
+			WithHeader([]string{"Name", "Sign", "Rating"}),
+			WithRows(data),
+			WithRowLine(true),
+			WithStyle(StyleDoubleHeader),
+		)
+		table.Render()
+
+		const want = `┌──────┬───────────────────────┬────────┐
+│ NAME │         SIGN          │ RATING │
+╞══════╪═══════════════════════╪════════╡
+│ A    │ The Good              │    500 │
+├──────┼───────────────────────┼────────┤
+│ A    │ The Very very Bad Man │    288 │
+├──────┼───────────────────────┼────────┤
+│ B    │ The Ugly              │    120 │
+└──────┴───────────────────────┴────────┘
+`
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should render merged cells with tees", func(t *testing.T) {
+		table, buf := NewBuffered(
+			WithHeader([]string{"Name", "Sign", "Rating"}),
+			WithRows(data),
+			WithRowLine(true),
+			WithMergeCells(true),
+			WithStyle(StyleRounded),
+		)
+		table.Render()
+
+		const want = `╭──────┬───────────────────────┬────────╮
+│ NAME │         SIGN          │ RATING │
+├──────┼───────────────────────┼────────┤
+│ A    │ The Good              │    500 │
+│      ├───────────────────────┼────────┤
+│      │ The Very very Bad Man │    288 │
+├──────┼───────────────────────┼────────┤
+│ B    │ The Ugly              │    120 │
+╰──────┴───────────────────────┴────────╯
+`
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should render without borders", func(t *testing.T) {
+		table, buf := NewBuffered(
+			WithHeader([]string{"Name", "Sign", "Rating"}),
+			WithRows(data),
+			WithAllBorders(false),
+			WithStyle(StyleHeavy),
+		)
+		table.Render()
+
+		const want = `  NAME ┃         SIGN          ┃ RATING
+━━━━━━━╋━━━━━━━━━━━━━━━━━━━━━━━╋━━━━━━━━━
+  A    ┃ The Good              ┃    500
+  A    ┃ The Very very Bad Man ┃    288
+  B    ┃ The Ugly              ┃    120
+`
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should override junctions with a center separator", func(t *testing.T) {
+		table, buf := NewBuffered(
+			WithHeader([]string{"Name"}),
+			WithRows([][]string{{"A"}}),
+			WithStyle(StyleDouble),
+			WithCenterSeparator("*"),
+		)
+		table.Render()
+
+		const want = `*══════*
+║ NAME ║
+*══════*
+║ A    ║
+*══════*
+`
+		checkEqual(t, buf.String(), want)
+	})
+}
+
+func TestBorderFormatters(t *testing.T) {
+	t.Parallel()
+
+	const (
+		red   = "\x1b[31m"
+		green = "\x1b[32m"
+		blue  = "\x1b[34m"
+		reset = "\x1b[0m"
+	)
+
+	options := []Option{
+		WithHeader([]string{"Name", "Rating"}),
+		WithRows([][]string{{"A", "500"}, {"B", "288"}}),
+		WithBorderFormatter(aurora.Red),
+	}
+
+	t.Run("should format the grid, the header separator and the frame", func(t *testing.T) {
+		table, buf := NewBuffered(append(options,
+			WithHeaderSeparatorFormatter(aurora.Green),
+			WithFrameFormatter(aurora.Blue),
+		)...)
+		require.NoError(t, table.Render())
+
+		want := blue + "+------+--------+" + reset + "\n" +
+			blue + "|" + reset + " NAME " + red + "|" + reset + " RATING " + blue + "|" + reset + "\n" +
+			green + "+------+--------+" + reset + "\n" +
+			blue + "|" + reset + " A    " + red + "|" + reset + "    500 " + blue + "|" + reset + "\n" +
+			blue + "|" + reset + " B    " + red + "|" + reset + "    288 " + blue + "|" + reset + "\n" +
+			blue + "+------+--------+" + reset + "\n"
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should format merged cells and footer separators", func(t *testing.T) {
+		table, buf := NewBuffered(append(options,
+			WithMergeCells(true),
+			WithRowSeparator(ROW),
+			WithRowLine(true),
+			WithRows([][]string{{"A", "500"}, {"A", "288"}}),
+			WithFooter([]string{"Total", "788"}),
+		)...)
+		require.NoError(t, table.Render())
+
+		line := red + "+-------+--------+" + reset + "\n"
+		want := line +
+			red + "|" + reset + " NAME  " + red + "|" + reset + " RATING " + red + "|" + reset + "\n" +
+			line +
+			red + "|" + reset + " A     " + red + "|" + reset + "    500 " + red + "|" + reset + "\n" +
+			red + "+" + reset + "       " + red + "+" + reset + red + "--------" + reset + red + "+" + reset + "\n" +
+			red + "|" + reset + "       " + red + "|" + reset + "    288 " + red + "|" + reset + "\n" +
+			line +
+			red + "|" + reset + " TOTAL " + red + "|" + reset + "  788   " + red + "|" + reset + "\n" +
+			red + "+" + reset + red + "-------" + reset + red + "+" + reset + red + "--------" + reset + red + "+" + reset + "\n"
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should not alter the layout", func(t *testing.T) {
+		data := WithRows([][]string{{"A", "The Very very Bad Man"}, {"B", "The Ugly"}})
+		plain, plainBuf := NewBuffered(data, WithMaxTableWidth(20))
+		styled, styledBuf := NewBuffered(data, WithMaxTableWidth(20), WithBorderFormatter(aurora.Faint))
+		require.NoError(t, plain.Render())
+		require.NoError(t, styled.Render())
+
+		require.Equal(t, plain.overhead(), styled.overhead())
+		require.NotEqual(t, plainBuf.String(), styledBuf.String())
+		checkEqual(t, string(ColorProfileNone.convert(styledBuf.Bytes())), plainBuf.String())
+	})
+}
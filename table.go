@@ -5,6 +5,7 @@ package tablewriter
 // this piece of software.
 
 import (
+	"bufio"
 	"bytes"
 	"fmt"
 	"io"
@@ -16,6 +17,7 @@ import (
 const (
 	headerRowIdx = -1
 	footerRowIdx = -2
+	noRowIdx     = -3 // e.g. above the top line of the table
 )
 
 type (
@@ -25,13 +27,28 @@ type (
 		*options
 
 		// internal multi-line representations of rows
+		//
+		// This layout state is recomputed from scratch whenever the table is rendered.
 		lines                   [][][]string
 		headers                 [][]string
-		footers                 [][]string
+		footers                 [][][]string // all the rows of the footer
+		headerValues            []string     // header, normalized to the number of columns
+		rowValues               [][]string   // rows, normalized to the number of columns
+		footerValues            [][]string   // all the rows of the footer, normalized to the number of columns
 		numColumns              int
 		columnsToAutoMergeCells map[int]bool
 		columnsAlign            []HAlignment
 		rowMaxHeight            map[int]int // max lines per cell
+		colWidth                map[int]int // actual width of a column
+		columnRanges            map[int]numericRange
+		spans                   []cellSpan           // cells spanning several rows or columns
+		spanOrigins             map[cellPos]cellSpan // span covering each cell
+		groups                  [][]Cell             // groups of columns above the header, by level and column
+		groupLines              [][][]string
+		rowOrder                []int             // order of rendering of the rows, when grouping rows
+		rowPositions            []int             // position of the rows within their group, when grouping rows
+		virtualRows             []virtualRow      // labels and subtotals of groups of rows
+		treeGuides              map[int]treeGuide // indentation of tree rows, by row
 
 		wrappers
 	}
@@ -46,9 +63,10 @@ func New(opts ...Option) *Table {
 	t := &Table{
 		lines:        [][][]string{},
 		headers:      [][]string{},
-		footers:      [][]string{},
+		footers:      [][][]string{},
 		numColumns:   -1,
 		rowMaxHeight: make(map[int]int),
+		colWidth:     make(map[int]int),
 
 		options: defaultOptions(opts),
 	}
@@ -64,8 +82,48 @@ func NewBuffered(opts ...Option) (*Table, *bytes.Buffer) {
 }
 
 // Append a row to the table.
+//
+// Cells covered by a cell spanning several rows above are omitted (see Cell.RowSpan).
 func (t *Table) Append(row []string) {
-	t.rows = append(t.rows, row)
+	covered := t.coveredColumns(len(t.rows))
+	if len(covered) == 0 {
+		t.rows = append(t.rows, row)
+
+		return
+	}
+
+	values := make([]string, 0, len(row)+len(covered))
+	for _, value := range row {
+		for covered[len(values)] {
+			values = append(values, "")
+		}
+
+		values = append(values, value)
+	}
+
+	t.rows = append(t.rows, values)
+}
+
+// ClearRows removes all the rows from the table, retaining header, footer and options.
+func (t *Table) ClearRows() {
+	t.rows = [][]string{}
+	t.richCells = nil
+	t.rowSpans = nil
+	t.rowDepths = nil
+}
+
+// ClearFooter removes the footer from the table.
+func (t *Table) ClearFooter() {
+	t.footer = nil
+}
+
+// Reset removes all the content from the table (header, rows and footer), retaining options.
+//
+// The table may then be fed with new data and rendered again.
+func (t *Table) Reset() {
+	t.header = nil
+	t.ClearRows()
+	t.ClearFooter()
 }
 
 // Rows of this table.
@@ -83,24 +141,89 @@ func (t *Table) Footer() []string {
 	return t.footer
 }
 
-// Render the table
-func (t *Table) Render() {
+// Render the table.
+//
+// The output is buffered and flushed to the configured io.Writer once the table is complete.
+//
+// With text and markdown tables, ANSI escape sequences are stripped or downgraded according to the color profile
+// of the output (see WithColorProfile).
+//
+// Rendering stops writing at the first failed write, and the error is returned.
+func (t *Table) Render() error {
 	t.prepare()
 
+	out := t.out
+	buffered := bufio.NewWriter(out)
+	t.out = buffered
+	defer func() {
+		t.out = out
+	}()
+
+	var colored *bytes.Buffer
+	if t.colorProfile != ColorProfileTrueColor && (t.format == FormatText || t.format == FormatMarkdown) {
+		// escape sequences are adapted to the color profile once the table is complete
+		colored = new(bytes.Buffer)
+		t.out = colored
+	}
+
+	var err error
+
+	switch t.format {
+	case FormatMarkdown:
+		t.renderMarkdown()
+	case FormatHTML:
+		t.renderHTML()
+	case FormatJSON:
+		err = t.renderJSON()
+	case FormatNDJSON:
+		err = t.renderNDJSON()
+	case FormatYAML:
+		err = t.renderYAML()
+	case FormatCSV:
+		t.renderCSV(t.csvDelimiter)
+	case FormatTSV:
+		t.renderCSV('\t')
+	default:
+		t.renderText()
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if colored != nil {
+		if _, err := buffered.Write(t.colorProfile.convert(colored.Bytes())); err != nil {
+			return err
+		}
+	}
+
+	return buffered.Flush()
+}
+
+// renderText renders the table as text, for display on a terminal.
+func (t *Table) renderText() {
+	firstRow, lastRow := t.firstRowIdx(), t.lastRowIdx()
+	switch {
+	case len(t.groups) > 0:
+		firstRow = headerGroupRowIdx(0)
+	case len(t.headers) > 0:
+		firstRow = noRowIdx
+	}
+
 	if t.borders.Top {
-		t.printSepLine(true)
+		t.printLineBetween(lineTop, noRowIdx, firstRow, true)
 	}
 
 	t.printHeader()
 
-	if t.autoMergeCells {
+	if t.autoMergeCells && len(t.spans) == 0 && len(t.rowOrder) == 0 {
 		t.printRowsMergeCells()
 	} else {
 		t.printRows()
 	}
 
 	if !t.separatorBetweenRows && t.borders.Bottom {
-		t.printSepLine(true)
+		t.printLineBetween(t.lastLineKind(), lastRow, noRowIdx, true)
 	}
 
 	t.printFooter()
@@ -135,11 +258,14 @@ func (t *Table) fillMaxWidths() {
 
 // setNumColumns determines the number of columns for this table, aligned to the row
 // (or header, or footer) with the largest number of columns.
+//
+// The content of the table is normalized to this number of columns for rendering, leaving
+// the header, rows and footer of the table unaltered.
 func (t *Table) setNumColumns() {
 	nCols := len(t.header)
 
-	for _, row := range t.rows {
-		if cols := len(row); cols > nCols {
+	for i := range t.rows {
+		if cols := t.rowWidth(i); cols > nCols {
 			nCols = cols
 		}
 	}
@@ -148,26 +274,29 @@ func (t *Table) setNumColumns() {
 		nCols = cols
 	}
 
-	// normalize all content to the same number of columns, adding trailing empty columns
 	if len(t.header) > 0 {
-		if missing := nCols - len(t.header); missing > 0 {
-			padded := make([]string, missing)
-			t.header = append(t.header, padded...)
+		for _, groups := range t.headerGroups {
+			if cols := headerGroupWidth(groups); cols > nCols {
+				nCols = cols
+			}
 		}
 	}
 
+	// normalize all content to the same number of columns, adding trailing empty columns
+	t.headerValues = nil
+	if len(t.header) > 0 {
+		t.headerValues = normalizeColumns(t.header, nCols)
+	}
+
+	t.rowValues = make([][]string, len(t.rows))
 	for i, row := range t.rows {
-		if missing := nCols - len(row); missing > 0 {
-			padded := make([]string, missing)
-			t.rows[i] = append(t.rows[i], padded...)
-		}
+		// the columns padded after cells spanning several columns are clipped
+		t.rowValues[i] = t.linkFallback(i, normalizeColumns(row, nCols))
 	}
 
+	t.footerValues = nil
 	if len(t.footer) > 0 {
-		if missing := nCols - len(t.footer); missing > 0 {
-			padded := make([]string, missing)
-			t.footer = append(t.footer, padded...)
-		}
+		t.footerValues = append(t.footerValues, normalizeColumns(t.footer, nCols))
 	}
 
 	t.numColumns = nCols
@@ -177,38 +306,129 @@ func (t *Table) lastCol() int {
 	return t.numColumns - 1
 }
 
-// center determines the character to print at an intersection line, based on the position and border.
-func (t *Table) center(i int) string {
+// center determines the character to print at the intersection of a line of some kind with
+// the right boundary of column i, based on the position and borders.
+//
+// The left boundary of the table is at i == -1.
+//
+// Junctions account for the cells spanning several columns or rows, in the rows above and below the line.
+func (t *Table) center(kind lineKind, i, above, below int) string {
+	up, down := kind != lineTop, kind != lineBottom
+	left := i > -1 && !t.isContinued(above, i)
+	right := i < t.lastCol() && !t.isContinued(above, i+1)
+
 	switch {
-	case i == -1 && !t.borders.Left: // ICI - strange: for isLeftMost we have i == 0 && !t.borders.Left
-		fallthrough // -
-	// return i == t.lastCol() && !t.borders.Right
-	case t.isRightMost(i):
-		return t.pRow // -
+	case i == -1 && t.numColumns == 0:
+		// a table without columns renders junctions at its left boundary
+		return t.style.junction(kind, up && t.borders.Left, down && t.borders.Left, false, true)
+	case i == -1:
+		return t.style.junction(kind, up && t.borders.Left, down && t.borders.Left, false, right)
+	case i == t.lastCol():
+		return t.style.junction(kind, up && t.borders.Right, down && t.borders.Right, left, false)
+	default:
+		up = up && !t.isJoined(above, i)
+		down = down && !t.isJoined(below, i)
+
+		return t.style.junction(kind, up, down, left, right)
+	}
+}
+
+// lineFormatter yields the formatter for a horizontal line of some kind, if any.
+func (t *Table) lineFormatter(kind lineKind) Formatter {
+	switch kind {
+	case lineTop, lineBottom:
+		return t.frameFormatter()
+	case lineHeader:
+		if t.headerSeparatorParams != nil {
+			return t.headerSeparatorParams
+		}
+
+		return t.borderParams
 	default:
-		return t.pCenter // +
+		return t.borderParams
+	}
+}
+
+// frameFormatter yields the formatter for the outer frame of the table, if any.
+func (t *Table) frameFormatter() Formatter {
+	if t.frameParams != nil {
+		return t.frameParams
+	}
+
+	return t.borderParams
+}
+
+// border formats the glyphs of a border. Blanks are not formatted.
+func border(glyphs string, formatter Formatter) string {
+	if len(strings.TrimSpace(glyphs)) == 0 {
+		return glyphs
 	}
+
+	return format(glyphs, formatter)
 }
 
-// PrintSepLine prints a separation line based on the row width.
+// vertical yields the vertical separator at the right boundary of column i.
 //
-// BUG(fred): this doesn't work well with noWhiteSpace
+// The left boundary of the table is at i == -1. The left and right boundaries are part of the frame.
+func (t *Table) vertical(i int) string {
+	if i == -1 || i == t.lastCol() {
+		return border(t.style.Vertical, t.frameFormatter())
+	}
+
+	return border(t.style.Vertical, t.borderParams)
+}
+
+// lastLineKind tells which kind of line closes the rows of the table.
+func (t *Table) lastLineKind() lineKind {
+	if len(t.footers) > 0 {
+		return lineMiddle
+	}
+
+	return lineBottom
+}
+
+// printSepLine prints a separation line between rows, based on the row width.
 func (t *Table) printSepLine(withNewLine bool) {
+	t.printLine(lineMiddle, withNewLine)
+}
+
+// printLine prints a horizontal line of the grid, based on the row width.
+func (t *Table) printLine(kind lineKind, withNewLine bool) {
+	t.printLineBetween(kind, noRowIdx, noRowIdx, withNewLine)
+}
+
+// printLineBetween prints a horizontal line of the grid between two rows.
+//
+// The line is interrupted below cells spanning several rows, and junctions are omitted inside cells
+// spanning several columns.
+//
+// BUG(fred): this doesn't work well with noWhiteSpace
+func (t *Table) printLineBetween(kind lineKind, above, below int, withNewLine bool) {
+	pRow := t.style.horizontal(kind)
+	var line strings.Builder
+
 	if !t.noWhiteSpace {
-		fmt.Fprint(t.out, t.center(-1)) // -
+		line.WriteString(t.center(kind, -1, above, below)) // -
 	}
 
 	for i := 0; i < t.numColumns; i++ {
+		segment := pRow
+		if t.isContinued(above, i) {
+			segment = SPACE
+		}
+
 		if !t.noWhiteSpace {
-			fmt.Fprint(t.out, t.pRow) // -
+			line.WriteString(segment) // -
 		}
-		fmt.Fprint(t.out, strings.Repeat(t.pRow, t.colWidth[i])) // -...-
+		line.WriteString(strings.Repeat(segment, t.colWidth[i])) // -...-
 		if !t.noWhiteSpace {
-			fmt.Fprint(t.out, t.pRow) // -
+			line.WriteString(segment) // -
 		}
-		fmt.Fprint(t.out, t.center(i)) // +|-
+		line.WriteString(t.center(kind, i, above, below)) // +|-
 	}
 
+	fmt.Fprint(t.out, border(line.String(), t.lineFormatter(kind)))
+
 	if withNewLine {
 		fmt.Fprint(t.out, t.newLine)
 	}
@@ -220,25 +440,26 @@ func (t *Table) printSepLine(withNewLine bool) {
 //
 // TODO(fred): this should be factorized with printSepLine
 func (t *Table) printLineOptionalCellSeparators(withNewLine bool, displayCellSeparator []bool) {
-	fmt.Fprint(t.out, t.pCenter) // ?? + never -???
+	isDisplayed := func(i int) bool {
+		return i >= len(displayCellSeparator) || displayCellSeparator[i]
+	}
+	pRow := t.style.Horizontal
+	formatter := t.lineFormatter(lineMiddle)
+
+	fmt.Fprint(t.out, border(t.style.junction(lineMiddle, true, true, false, isDisplayed(0)), formatter))
 
 	for i := 0; i < t.numColumns; i++ {
 		colWidth := t.colWidth[i]
 
-		if i > len(displayCellSeparator) || displayCellSeparator[i] {
+		if isDisplayed(i) {
 			// display the cell separator
-			fmt.Fprintf(t.out, "%s%s%s%s",
-				t.pRow,
-				strings.Repeat(t.pRow, colWidth),
-				t.pRow,
-				t.pCenter, // t.center(i),
-			)
+			fmt.Fprint(t.out, border(strings.Repeat(pRow, colWidth+2), formatter))
 		} else {
 			// don't display the cell separator for this cell
-			fmt.Fprintf(t.out, "%s%s",
-				strings.Repeat(SPACE, colWidth+2),
-				t.pCenter)
+			fmt.Fprint(t.out, strings.Repeat(SPACE, colWidth+2))
 		}
+
+		fmt.Fprint(t.out, border(t.style.junction(lineMiddle, true, true, isDisplayed(i), i < t.lastCol() && isDisplayed(i+1)), formatter))
 	}
 
 	if withNewLine {
@@ -274,7 +495,7 @@ func (t *Table) hasEscSeq(params map[int]Formatter) bool {
 func (t *Table) startOfLinePad() string {
 	if !t.noWhiteSpace {
 		return stringIf(t.borders.Left,
-			t.pColumn, // |
+			t.vertical(-1), // |
 			t.tablePadding,
 		)
 	}
@@ -301,15 +522,48 @@ func (t *Table) transformer(params map[int]Formatter) colTransformer {
 	}
 }
 
+// printHeader prints the groups of columns, if any, then the header.
 func (t *Table) printHeader() {
 	if len(t.headers) == 0 {
 		return
 	}
 
+	for level, groupLines := range t.groupLines {
+		row := headerGroupRowIdx(level)
+		aligner := func(col int) padFunc { return t.groupAlignment(level, col).padder() }
+
+		t.printHeading(groupLines, row, aligner, t.groupFormatters(level), func(col int) int { return t.colSpan(row, col) })
+
+		if t.separatorAfterHeader {
+			below := headerRowIdx
+			if level < len(t.groupLines)-1 {
+				below = headerGroupRowIdx(level + 1)
+			}
+
+			t.printLineBetween(lineMiddle, row, below, true)
+		}
+	}
+
 	padder := t.headerAlign.padder()
 	aligner := func(_ int) padFunc { return padder }
-	maxHeight := t.rowMaxHeight[headerRowIdx]
-	headerLines := normalizeRowHeight(t.headers, maxHeight)
+
+	t.printHeading(t.headers, headerRowIdx, aligner, t.headerParams, nil)
+
+	if t.separatorAfterHeader {
+		t.printLineBetween(lineHeader, noRowIdx, t.firstRowIdx(), true)
+	}
+}
+
+// printHeading prints a row of the header, or of the groups of columns above the header.
+func (t *Table) printHeading(
+	cells [][]string,
+	row int,
+	aligner colAligner,
+	params map[int]Formatter,
+	spanner func(col int) int,
+) {
+	maxHeight := t.rowMaxHeight[row]
+	headerLines := normalizeRowHeight(cells, maxHeight, t.rowVAligner(row))
 
 	colLeftPad := func(in string, i, _ int) string {
 		if len(in) == 0 && t.isRightMost(i) {
@@ -329,14 +583,14 @@ func (t *Table) printHeader() {
 		}
 
 		var middlePad string
-		if t.hasEscSeq(t.headerParams) || !t.noWhiteSpace { // why when escape seq???
+		if t.hasEscSeq(params) || !t.noWhiteSpace { // why when escape seq???
 			middlePad = SPACE
 		}
 
 		if !t.noWhiteSpace {
 			return middlePad + stringIf(
 				t.isRightMost(i),
-				SPACE, t.pColumn,
+				SPACE, t.vertical(i),
 			)
 		}
 
@@ -347,7 +601,7 @@ func (t *Table) printHeader() {
 	}
 
 	prepadding := t.headerPrepadder()
-	transform := t.transformer(t.headerParams)
+	transform := t.transformer(params)
 
 	t.renderRowWithPadding(
 		headerLines,
@@ -357,11 +611,8 @@ func (t *Table) printHeader() {
 		prepadding,
 		transform,
 		t.startOfLinePad,
+		spanner,
 	)
-
-	if t.separatorAfterHeader {
-		t.printSepLine(true)
-	}
 }
 
 // renderRowWithPadding captures the rendering logic to display a single row of any type.
@@ -376,6 +627,7 @@ func (t *Table) renderRowWithPadding(
 	prepadder transformer,
 	transform colTransformer,
 	lineStarter func() string,
+	spanner func(col int) int,
 ) {
 	if prepadder == nil {
 		prepadder = identity
@@ -387,37 +639,68 @@ func (t *Table) renderRowWithPadding(
 		}
 
 		for col := 0; col < t.numColumns; col++ {
+			span := 1
+			if spanner != nil {
+				span = spanner(col)
+			}
+
+			if span == 0 {
+				// hidden by a cell spanning several columns
+				continue
+			}
+
+			lastCol := col + span - 1
 			cellPadder := cellAligner(col) // each column may use a different alignment
 			colWidth := t.colWidth[col]
+			if span > 1 {
+				colWidth = t.spanWidth(col, span)
+			}
 			value := cells[col][line]
 
 			fmt.Fprint(t.out, leftPadder(value, col, line))
-			fmt.Fprint(t.out, transform(col)(
+			fmt.Fprint(t.out, transform(lastCol)(
 				cellPadder(
 					prepadder(value),
 					SPACE, colWidth,
 				),
 			))
-			fmt.Fprint(t.out, rightPadder(value, col, line))
+			fmt.Fprint(t.out, rightPadder(value, lastCol, line))
 		}
 
 		fmt.Fprint(t.out, t.newLine)
 	}
 }
 
+// printFooter prints all the rows of the footer.
 func (t *Table) printFooter() {
 	if len(t.footers) == 0 {
 		return
 	}
 
+	if !t.borders.Bottom {
+		t.printLineBetween(lineMiddle, t.lastRowIdx(), noRowIdx, true)
+	}
+
+	for _, cells := range t.footers {
+		t.printFooterRow(cells)
+	}
+
+	if t.separatorAfterFooter {
+		t.printFooterSeparator()
+	}
+}
+
+// printFooterRow prints a single multi-lines row of the footer.
+//
+// Blank cells are rendered without a vertical separator on their right.
+func (t *Table) printFooterRow(cells [][]string) {
 	padder := t.footerAlign.padder()
 	aligner := func(_ int) padFunc { return padder }
-	maxHeight := t.rowMaxHeight[footerRowIdx]
-	footerLines := normalizeRowHeight(t.footers, maxHeight)
-
-	if !t.borders.Bottom {
-		t.printSepLine(true)
+	maxHeight := 0
+	for _, lines := range cells {
+		maxHeight = max(maxHeight, len(lines))
 	}
+	footerLines := normalizeRowHeight(cells, maxHeight, t.rowVAligner(footerRowIdx))
 
 	colLeftPad := func(in string, i, _ int) string {
 		if len(in) == 0 && t.isRightMost(i) {
@@ -426,24 +709,17 @@ func (t *Table) printFooter() {
 		return SPACE
 	}
 
-	erasePad := make([]bool, len(t.footers))
-	colRightPad := func(in string, i, j int) string {
-		if j == 0 {
-			// right padding on first line of footer
-			if len(strings.TrimRightFunc(in, wrap.BlankSplitter)) == 0 {
-				erasePad[i] = true
-
-				return stringIf(t.isRightMost(i), NOPADDING, SPACE+SPACE)
-			}
-
-			return stringIf(t.isRightMost(i), NOPADDING, SPACE+t.pColumn)
-		}
+	erasePad := make([]bool, len(cells))
+	for i, lines := range footerLines {
+		erasePad[i] = isBlankCell(lines)
+	}
 
+	colRightPad := func(_ string, i, _ int) string {
 		if erasePad[i] {
 			return stringIf(t.isRightMost(i), NOPADDING, SPACE+SPACE)
 		}
 
-		return stringIf(t.isRightMost(i), NOPADDING, SPACE+t.pColumn)
+		return stringIf(t.isRightMost(i), NOPADDING, SPACE+t.vertical(i))
 	}
 
 	prepadding := t.headerPrepadder()
@@ -457,67 +733,71 @@ func (t *Table) printFooter() {
 		prepadding,
 		transform,
 		t.startOfLinePad,
+		nil,
 	)
-
-	if t.separatorAfterFooter {
-		t.printFooterSeparator()
-	}
 }
 
 // print special separator line below the footer
+//
+// The separator is interrupted below the blank cells of the last row of the footer.
 func (t *Table) printFooterSeparator() {
+	last := t.footers[len(t.footers)-1]
+	isBlank := func(col int) bool {
+		return isBlankCell(last[col])
+	}
 	hasPrinted := false
+	pRow := t.style.Horizontal
+	formatter := t.lineFormatter(lineBottom)
 
 	for col := 0; col < t.numColumns; col++ {
 		colWidth := t.colWidth[col]
-		pad := t.pRow
-		center := t.pCenter
-		length := len(t.footers[col][0])
+		pad := pRow
+		pCenter := stringIf(col == t.lastCol(), t.style.BottomRight, t.style.BottomMid)
+		center := pCenter
+		blank := isBlank(col)
 
-		if length > 0 {
+		if !blank {
 			hasPrinted = true
 		}
 
-		if length == 0 && !t.borders.Right {
+		if blank && !t.borders.Right {
 			center = SPACE
 		}
 
 		if col == 0 {
-			if length > 0 && !t.borders.Left {
-				center = t.pRow
+			if !blank && !t.borders.Left {
+				center = pRow
 			}
-			fmt.Fprint(t.out, center)
+			fmt.Fprint(t.out, border(stringIf(center == pCenter, t.style.BottomLeft, center), formatter))
 		}
 
-		if length == 0 {
+		if blank {
 			pad = SPACE
 		}
 
 		if hasPrinted || t.borders.Left {
-			pad = t.pRow
-			center = t.pCenter
+			pad = pRow
+			center = pCenter
 		}
 
 		if center != SPACE {
 			if col == t.lastCol() && !t.borders.Right {
-				center = t.pRow
+				center = pRow
 			}
 		}
 
 		if center == SPACE {
-			if col < t.lastCol() && len(t.footers[col+1][0]) != 0 {
+			if col < t.lastCol() && !isBlank(col+1) {
 				if !t.borders.Left {
-					center = t.pRow
+					center = pRow
 				} else {
-					center = t.pCenter
+					center = t.style.BottomLeft
 				}
 			}
 		}
 
-		fmt.Fprint(t.out, pad)
-		fmt.Fprint(t.out, strings.Repeat(pad, colWidth))
-		fmt.Fprint(t.out, pad)
-		fmt.Fprint(t.out, center)
+		fmt.Fprint(t.out, border(strings.Repeat(pad, colWidth+2), formatter))
+		fmt.Fprint(t.out, border(center, formatter))
 	}
 
 	fmt.Fprint(t.out, t.newLine)
@@ -553,7 +833,7 @@ func (t Table) Overhead() int {
 func (t Table) overhead() int {
 	var chars int
 
-	colSepWidth := wrap.DisplayWidth(t.pColumn)
+	colSepWidth := wrap.DisplayWidth(t.style.Vertical)
 	paddingWidth := wrap.DisplayWidth(t.tablePadding)
 
 	if !t.noWhiteSpace {
@@ -570,39 +850,66 @@ func (t Table) overhead() int {
 	return 0
 }
 
-// printRows renders all multi-lines rows
-func (t Table) printRows() {
-	for i, rowLines := range t.lines {
-		t.printRow(rowLines, i)
-	}
-}
+// printRows renders all multi-lines rows, with the labels and subtotals of groups, if any.
+func (t *Table) printRows() {
+	order := t.displayOrder()
 
-func (t *Table) cellAligner(col int) padFunc {
-	return t.columnsAlign[col].padder()
+	for k, rowIdx := range order {
+		if rowIdx < len(t.lines) {
+			t.printRow(t.lines[rowIdx], rowIdx)
+		} else {
+			t.printVirtualRow(rowIdx)
+		}
+
+		switch {
+		case k == len(order)-1:
+			if t.separatorBetweenRows {
+				t.printLineBetween(t.lastLineKind(), rowIdx, noRowIdx, true)
+			}
+		case t.separatorBetweenRows || (t.groupSeparator && t.isGroupLabel(order[k+1])):
+			t.printLineBetween(lineMiddle, rowIdx, order[k+1], true)
+		}
+	}
 }
 
 // printRow renders a single multi-lines row
 func (t *Table) printRow(columns [][]string, rowIdx int) {
 	maxHeight := t.rowMaxHeight[rowIdx]
-	columns = normalizeRowHeight(columns, maxHeight)
-
-	aligner := t.cellAligner
-	transform := t.transformer(t.columnsParams)
+	columns = normalizeRowHeight(columns, maxHeight, t.rowVAligner(rowIdx))
+	columns = t.linkColumns(rowIdx, columns)
+
+	t.printRowLines(columns, rowIdx, maxHeight,
+		t.rowAligner(rowIdx),
+		t.transformer(t.rowFormatters(rowIdx)),
+		func(col int) Formatter {
+			// the zebra stripe covers the padding inside cells
+			return t.cellBand(rowIdx, col)
+		},
+	)
+}
 
+// printRowLines renders the lines of a row, with the padding of table rows.
+func (t *Table) printRowLines(
+	columns [][]string,
+	rowIdx, maxHeight int,
+	aligner colAligner,
+	transform colTransformer,
+	band func(col int) Formatter,
+) {
 	colLeftPad := func(in string, i, _ int) string {
 		if t.isRightMost(i) {
 			if !t.noWhiteSpace {
 				if len(strings.TrimRightFunc(in, wrap.BlankSplitter)) > 0 {
-					return stringIf(t.isLeftMost(i), SPACE, t.pColumn) + SPACE
+					return stringIf(t.isLeftMost(i), SPACE, t.vertical(i-1)) + format(SPACE, band(i))
 				}
-				return stringIf(t.isLeftMost(i), SPACE, t.pColumn)
+				return stringIf(t.isLeftMost(i), SPACE, t.vertical(i-1))
 			}
 
 			return NOPADDING
 		}
 
 		if !t.noWhiteSpace {
-			return stringIf(t.isLeftMost(i), SPACE, t.pColumn) + SPACE
+			return stringIf(t.isLeftMost(i), SPACE, t.vertical(i-1)) + format(SPACE, band(i))
 		}
 
 		return NOPADDING
@@ -614,7 +921,11 @@ func (t *Table) printRow(columns [][]string, rowIdx int) {
 		}
 
 		if !t.noWhiteSpace && t.borders.Right && i == t.lastCol() {
-			return SPACE + stringIf(t.borders.Right, t.pColumn, SPACE)
+			return format(SPACE, band(i)) + stringIf(t.borders.Right, t.vertical(i), SPACE)
+		}
+
+		if !t.noWhiteSpace {
+			return format(t.tablePadding, band(i))
 		}
 
 		return t.tablePadding
@@ -628,11 +939,8 @@ func (t *Table) printRow(columns [][]string, rowIdx int) {
 		nil, // at this moment, we don't have cell transforms configurable for rows
 		transform,
 		nil, // at this moment, the padding logic for rendering row is different: in that case, no start-of-line padding
+		func(col int) int { return t.colSpan(rowIdx, col) },
 	)
-
-	if t.separatorBetweenRows {
-		t.printSepLine(true)
-	}
 }
 
 // Print the rows of the table and merge the cells that are identical
@@ -642,10 +950,11 @@ func (t *Table) printRowsMergeCells() {
 		displayCellBorder []bool
 		tmpWriter         bytes.Buffer
 	)
+	mergeStart := make([]int, t.numColumns)
 
 	for i, lines := range t.lines {
 		// we store the display of the current line in a tmp writer, as we need to know which border needs to be print above
-		previousLine, displayCellBorder = t.printRowMergeCells(&tmpWriter, lines, i, previousLine)
+		previousLine, displayCellBorder = t.printRowMergeCells(&tmpWriter, lines, i, previousLine, mergeStart)
 		if i > 0 { // we don't need to print borders above first line
 			if t.separatorBetweenRows {
 				t.printLineOptionalCellSeparators(true, displayCellBorder)
@@ -655,7 +964,7 @@ func (t *Table) printRowsMergeCells() {
 	}
 
 	if t.separatorBetweenRows {
-		t.printSepLine(true)
+		t.printLine(t.lastLineKind(), true)
 	}
 }
 
@@ -663,25 +972,28 @@ func (t *Table) printRowsMergeCells() {
 // Adjust column alignment based on type
 //
 // TODO(fred): this should be refactored along the same lines as printHeader and printRow.
-func (t *Table) printRowMergeCells(writer io.Writer, columns [][]string, rowIdx int, previousLine []string) ([]string, []bool) {
+//
+// mergeStart holds, for each column, the index of the row where the current merged cell starts.
+func (t *Table) printRowMergeCells(
+	writer io.Writer,
+	columns [][]string,
+	rowIdx int,
+	previousLine []string,
+	mergeStart []int,
+) ([]string, []bool) {
 	max := t.rowMaxHeight[rowIdx]
 	numColumns := len(columns)
-	columns = normalizeRowHeight(columns, max)
+	columns = normalizeRowHeight(columns, max, t.rowVAligner(rowIdx))
 
 	var displayCellBorder []bool
 	for x := 0; x < max; x++ {
 		for y := 0; y < numColumns; y++ {
 
 			// Check if border is set
-			fmt.Fprint(writer, stringIf((!t.borders.Left && y == 0), SPACE, t.pColumn))
-			fmt.Fprint(writer, SPACE)
+			fmt.Fprint(writer, stringIf((!t.borders.Left && y == 0), SPACE, t.vertical(y-1)))
 
 			str := columns[y][x]
-
-			// Embedding escape sequence with column value
-			if t.hasEscSeq(t.columnsParams) {
-				str = format(str, t.columnsParams[y])
-			}
+			merged := false
 
 			if t.autoMergeCells {
 				var mergeCell bool
@@ -695,47 +1007,58 @@ func (t *Table) printRowMergeCells(writer io.Writer, columns [][]string, rowIdx
 					mergeCell = true
 				}
 
-				// Store the full line to merge mutli-lines cells
-				fullLine := strings.TrimRight(strings.Join(columns[y], SPACE), SPACE)
+				// Store the full line to merge mutli-lines cells, regardless of the vertical alignment
+				fullLine := strings.Trim(strings.Join(columns[y], SPACE), SPACE)
 				if len(previousLine) > y && fullLine == previousLine[y] && fullLine != NOPADDING && mergeCell {
 					// If this cell is identical to the one above but not empty, we don't display the border and keep the cell empty.
 					displayCellBorder = append(displayCellBorder, false)
 					str = NOPADDING
+					merged = true
 				} else {
 					// First line or different content, keep the content and print the cell border
 					displayCellBorder = append(displayCellBorder, true)
 				}
 			}
 
-			// This would print alignment
-			// Default alignment  would use multiple configuration
-			switch t.columnsAlign[y] {
-			case AlignCenter: //
-				fmt.Fprintf(writer, "%s", padCenter(str, SPACE, t.colWidth[y]))
-			case AlignRight:
-				fmt.Fprintf(writer, "%s", padLeft(str, SPACE, t.colWidth[y]))
-			case AlignLeft:
-				fmt.Fprintf(writer, "%s", padRight(str, SPACE, t.colWidth[y]))
-			default:
+			if !merged {
+				mergeStart[y] = rowIdx
+			}
+
+			// a merged cell keeps the band of the row where it starts
+			band := t.rowBand(mergeStart[y])
+			align := t.cellAlignment(rowIdx, y)
+			if align == AlignDefault {
+				// numbers are detected before formatting
+				align = AlignLeft
 				if isNumerical(str) {
-					fmt.Fprintf(writer, "%s", padLeft(str, SPACE, t.colWidth[y]))
-				} else {
-					fmt.Fprintf(writer, "%s", padRight(str, SPACE, t.colWidth[y]))
+					align = AlignRight
 				}
 			}
-			fmt.Fprint(writer, SPACE)
+			padder := align.padder()
+			formatter := t.cellFormatter(rowIdx, y)
+			str = t.linkLine(rowIdx, y, str)
+
+			fmt.Fprint(writer, format(SPACE, band))
+			if band == nil {
+				// Embedding escape sequence with column (or cell) value
+				fmt.Fprint(writer, padder(format(str, formatter), SPACE, t.colWidth[y]))
+			} else {
+				// the band covers the padding of the cell
+				fmt.Fprint(writer, format(padder(str, SPACE, t.colWidth[y]), chainFormatters(band, formatter)))
+			}
+			fmt.Fprint(writer, format(SPACE, band))
 		}
 
 		// Check if border is set
 		// Replace with space if not set
-		fmt.Fprint(writer, stringIf(t.borders.Left, t.pColumn, SPACE))
+		fmt.Fprint(writer, stringIf(t.borders.Left, t.vertical(t.lastCol()), SPACE))
 		fmt.Fprint(writer, t.newLine)
 	}
 
 	// The new previous line is the current one
 	previousLine = make([]string, numColumns)
 	for y := 0; y < numColumns; y++ {
-		previousLine[y] = strings.TrimRight(strings.Join(columns[y], SPACE), SPACE) // store the full line for multi-lines cells
+		previousLine[y] = strings.Trim(strings.Join(columns[y], SPACE), SPACE) // store the full line for multi-lines cells
 	}
 
 	// Returns the newly added line and wether or not a border should be displayed above.
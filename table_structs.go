@@ -4,16 +4,75 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"sort"
+	"strconv"
+	"strings"
 )
 
+const structPathSeparator = "."
+
+var stringerType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+
+// structField describes how to render a field of a struct as a column.
+type structField struct {
+	index     []int // path to the field, through flattened structs
+	heading   string
+	align     HAlignment
+	maxWidth  int
+	minWidth  int
+	format    string
+	omitEmpty bool
+}
+
+// FromSlice builds a Table writer with header and rows from a slice of structs, or a slice of maps.
+//
+// With structs (or pointers to structs), the header is derived from the type T, even when the slice is empty.
+// See SetStructs for the struct tags and options supported.
+//
+// With maps, the header is made of the union of all keys, in sorted order. Missing keys yield blank cells.
+// WithStructColumns may be used to select and order keys.
+//
+// If T is an interface type, the header is derived from the first non-nil element.
+func FromSlice[T any](values []T, opts ...Option) (*Table, error) {
+	table := New(opts...)
+	vv := reflect.ValueOf(values)
+
+	if reflect.TypeOf((*T)(nil)).Elem().Kind() == reflect.Interface && vv.Len() < 1 {
+		return nil, errors.New("empty value")
+	}
+
+	if err := table.setSliceRows(vv); err != nil {
+		return nil, err
+	}
+
+	return table, nil
+}
+
 // SetStructs sets header and rows from slice of struct.
 //
 // If something that is not a slice is passed, an error will be returned.
 //
 // The tag specified by "tablewriter" for the struct becomes the header.
-// If not specified or empty, the field name will be used.
+// If not specified or empty, the field name will be used. The tag key may be changed with WithStructTag
+// (e.g. to reuse "json" names). Fields tagged with "-" and unexported fields are skipped.
+//
+// The tag may specify options after the heading, separated by commas:
+//   - omitempty: zero values are rendered as blank cells
+//   - align=left|right|center: the alignment of the column
+//   - width=N: the maximum width of the column
+//   - minwidth=N: the minimum width of the column
+//   - format=verb: the fmt verb used to render values, e.g. format=%.2f. This must be the last option.
+//
+// Options set explicitly with WithColAlignment, WithColMaxWidth or WithColMinWidth take precedence over tags.
+//
+// Embedded structs are flattened, so their fields appear as columns. Nested structs are flattened as well
+// with WithStructFlatten, with headings like "Address.City". A nested struct of a type already being flattened,
+// e.g. a pointer to a parent of the same type, is rendered as a single column.
+//
+// The fields are inferred from the type of the elements of the slice.
+// For slices of interfaces, the type of the first non-nil element is used.
+// Columns may be selected and ordered with WithStructColumns.
 //
-// The field of the first element of the slice is used as the header.
 // If the element implements fmt.Stringer, the result will be used.
 // And the slice contains nil, it will be skipped without rendering.
 func (t *Table) SetStructs(v interface{}) error {
@@ -37,57 +96,191 @@ func (t *Table) setStructSlice(vv reflect.Value) error {
 		return errors.New("empty value")
 	}
 
-	// check first element to set header
-	first := vv.Index(0)
-	e, err := getElementType(first)
+	return t.setSliceRows(vv)
+}
+
+// setSliceRows sets header and rows from a slice of structs or maps.
+func (t *Table) setSliceRows(vv reflect.Value) error {
+	e, err := getElementType(vv)
 	if err != nil {
 		return err
 	}
 
-	n := e.NumField()
-	headers := make([]string, n)
-	for i := 0; i < n; i++ {
-		f := e.Field(i)
-		header := f.Tag.Get("tablewriter") // TODO: option to set user-defined tag
-		if header == "" {
-			header = f.Name
-		}
-		headers[i] = header
+	if e.Kind() == reflect.Map {
+		return t.setMapRows(vv)
+	}
+
+	fields, err := t.structFields(e)
+	if err != nil {
+		return err
+	}
+
+	headers := make([]string, len(fields))
+	for i, field := range fields {
+		headers[i] = field.heading
 	}
 
 	t.header = headers
+	t.applyStructFields(fields)
 
 	for i := 0; i < vv.Len(); i++ {
-		item := reflect.Indirect(vv.Index(i))
-		itemType := reflect.TypeOf(item)
-		switch itemType.Kind() {
-		case reflect.Struct:
-			// OK
-		default:
-			return fmt.Errorf("invalid item type %v", itemType.Kind())
-		}
-		if !item.IsValid() {
+		item, ok := indirectValue(vv.Index(i))
+		if !ok {
 			// skip rendering
 			continue
 		}
-		nf := item.NumField()
-		if n != nf {
-			return errors.New("invalid num of field")
+
+		if item.Type() != e {
+			return fmt.Errorf("invalid item type %v", item.Type())
+		}
+
+		rows := make([]string, len(fields))
+		for j, field := range fields {
+			rows[j] = field.render(item)
 		}
-		rows := make([]string, nf)
-		for j := 0; j < nf; j++ {
-			f := reflect.Indirect(item.Field(j))
-			if f.Kind() == reflect.Ptr {
-				f = f.Elem()
+
+		t.Append(rows)
+	}
+
+	return nil
+}
+
+// structFields determines the columns rendered for a struct type.
+func (t *Table) structFields(e reflect.Type) ([]structField, error) {
+	fields, err := t.collectStructFields(e, nil, "", map[reflect.Type]bool{e: true})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(t.structColumns) == 0 {
+		return fields, nil
+	}
+
+	selected := make([]structField, 0, len(t.structColumns))
+	for _, name := range t.structColumns {
+		found := false
+		for _, field := range fields {
+			if field.heading == name {
+				selected = append(selected, field)
+				found = true
+
+				break
 			}
-			if f.IsValid() {
-				if s, ok := f.Interface().(fmt.Stringer); ok {
-					rows[j] = s.String()
-					continue
+		}
+
+		if !found {
+			return nil, fmt.Errorf("column %q not found in %v", name, e)
+		}
+	}
+
+	return selected, nil
+}
+
+// collectStructFields collects the fields of a struct type, flattening embedded and nested structs.
+//
+// The path holds the struct types being flattened: a field of a type already on the path is rendered as a single
+// column, so recursive types are not flattened forever.
+func (t *Table) collectStructFields(e reflect.Type, index []int, prefix string, path map[reflect.Type]bool) ([]structField, error) {
+	fields := make([]structField, 0, e.NumField())
+
+	for i := 0; i < e.NumField(); i++ {
+		f := e.Field(i)
+		if !f.IsExported() && !f.Anonymous {
+			continue
+		}
+
+		field, skip, err := parseStructField(f, t.structTag)
+		if err != nil {
+			return nil, err
+		}
+
+		if skip {
+			continue
+		}
+
+		field.index = append(append(make([]int, 0, len(index)+1), index...), i)
+		field.heading = prefix + field.heading
+
+		if isFlattenable(f.Type) && !path[indirectType(f.Type)] {
+			// embedded structs are flattened, unless they are explicitly named by a tag
+			embedded := f.Anonymous && field.heading == prefix+f.Name
+
+			if embedded || (t.structFlatten && f.IsExported()) {
+				nestedPrefix := prefix
+				if !embedded {
+					nestedPrefix = field.heading + structPathSeparator
 				}
-				rows[j] = fmt.Sprint(f)
-			} else {
-				rows[j] = "nil"
+
+				nestedType := indirectType(f.Type)
+				path[nestedType] = true
+				nested, err := t.collectStructFields(nestedType, field.index, nestedPrefix, path)
+				delete(path, nestedType)
+				if err != nil {
+					return nil, err
+				}
+
+				fields = append(fields, nested...)
+
+				continue
+			}
+		}
+
+		if !f.IsExported() {
+			continue
+		}
+
+		fields = append(fields, field)
+	}
+
+	return fields, nil
+}
+
+// setMapRows sets header and rows from a slice of maps.
+func (t *Table) setMapRows(vv reflect.Value) error {
+	keys := make(map[string]bool)
+	items := make([]reflect.Value, 0, vv.Len())
+
+	for i := 0; i < vv.Len(); i++ {
+		item, ok := indirectValue(vv.Index(i))
+		if !ok || (item.Kind() == reflect.Map && item.IsNil()) {
+			// skip rendering
+			continue
+		}
+
+		if item.Kind() != reflect.Map {
+			return fmt.Errorf("invalid item type %v", item.Type())
+		}
+
+		for _, key := range item.MapKeys() {
+			keys[fmt.Sprint(key.Interface())] = true
+		}
+
+		items = append(items, item)
+	}
+
+	header := t.structColumns
+	if len(header) == 0 {
+		header = make([]string, 0, len(keys))
+		for key := range keys {
+			header = append(header, key)
+		}
+
+		sort.Strings(header)
+	}
+
+	t.header = header
+
+	for _, item := range items {
+		values := make(map[string]reflect.Value, item.Len())
+		iter := item.MapRange()
+		for iter.Next() {
+			values[fmt.Sprint(iter.Key().Interface())] = iter.Value()
+		}
+
+		rows := make([]string, len(header))
+		for j, key := range header {
+			if value, ok := values[key]; ok {
+				rows[j] = renderValue(value, "")
 			}
 		}
 
@@ -97,22 +290,178 @@ func (t *Table) setStructSlice(vv reflect.Value) error {
 	return nil
 }
 
-func getElementType(first reflect.Value) (reflect.Type, error) {
-	e := first.Type()
-	switch e.Kind() {
-	case reflect.Struct:
-		// OK
-	case reflect.Ptr:
-		if first.IsNil() {
-			return e, errors.New("the first element is nil")
+// applyStructFields applies the column options specified by struct tags.
+func (t *Table) applyStructFields(fields []structField) {
+	perColumnAlign := make(map[int]HAlignment, len(fields))
+	for col, field := range fields {
+		if field.align != AlignDefault {
+			perColumnAlign[col] = field.align
 		}
-		e = first.Elem().Type()
-		if e.Kind() != reflect.Struct {
-			return e, fmt.Errorf("invalid kind %s", e.Kind())
+
+		if _, isDefined := t.colMaxWidth[col]; !isDefined && field.maxWidth > 0 {
+			t.colMaxWidth[col] = field.maxWidth
 		}
+
+		if _, isDefined := t.colMinWidth[col]; !isDefined && field.minWidth > 0 {
+			t.colMinWidth[col] = field.minWidth
+		}
+	}
+
+	for col, align := range t.perColumnAlign {
+		perColumnAlign[col] = align
+	}
+
+	t.perColumnAlign = perColumnAlign
+}
+
+// parseStructField parses the tag of a struct field, e.g. `tablewriter:"Price,align=right,format=%.2f"`.
+func parseStructField(f reflect.StructField, key string) (structField, bool, error) {
+	parts := strings.Split(f.Tag.Get(key), ",")
+	field := structField{heading: parts[0]}
+
+	if field.heading == "-" {
+		return field, true, nil
+	}
+
+	if field.heading == "" {
+		field.heading = f.Name
+	}
+
+	for i, part := range parts[1:] {
+		option, value, _ := strings.Cut(part, "=")
+
+		switch strings.TrimSpace(option) {
+		case "omitempty":
+			field.omitEmpty = true
+		case "align":
+			switch strings.TrimSpace(value) {
+			case "left":
+				field.align = AlignLeft
+			case "right":
+				field.align = AlignRight
+			case "center":
+				field.align = AlignCenter
+			default:
+				return field, false, fmt.Errorf("invalid alignment %q for field %s", value, f.Name)
+			}
+		case "width", "minwidth":
+			width, err := strconv.Atoi(strings.TrimSpace(value))
+			if err != nil || width < 0 {
+				return field, false, fmt.Errorf("invalid %s %q for field %s", option, value, f.Name)
+			}
+
+			if option == "width" {
+				field.maxWidth = width
+			} else {
+				field.minWidth = width
+			}
+		case "format":
+			// the format verb may contain commas
+			field.format = strings.Join(append([]string{value}, parts[i+2:]...), ",")
+
+			return field, false, nil
+		}
+		// other options, e.g. from json tags, are ignored
+	}
+
+	return field, false, nil
+}
+
+// render a field of a struct as a cell.
+func (f structField) render(item reflect.Value) string {
+	v := item
+	for i, index := range f.index {
+		if i > 0 {
+			var ok bool
+			if v, ok = indirectValue(v); !ok {
+				// nil pointer to a flattened struct
+				return "nil"
+			}
+		}
+
+		v = v.Field(index)
+	}
+
+	if f.omitEmpty && v.IsZero() {
+		return ""
+	}
+
+	return renderValue(v, f.format)
+}
+
+// renderValue renders a value as a cell, with an optional fmt verb.
+func renderValue(v reflect.Value, format string) string {
+	v, ok := indirectValue(v)
+	if !ok {
+		return "nil"
+	}
+
+	if format != "" {
+		return fmt.Sprintf(format, v)
+	}
+
+	if v.CanInterface() { // fields promoted from unexported embedded structs can't be accessed as interfaces
+		if s, ok := v.Interface().(fmt.Stringer); ok {
+			return s.String()
+		}
+	}
+
+	return fmt.Sprint(v)
+}
+
+// isFlattenable tells if a field of some type may be flattened into several columns.
+func isFlattenable(ft reflect.Type) bool {
+	ft = indirectType(ft)
+
+	return ft.Kind() == reflect.Struct &&
+		!ft.Implements(stringerType) && !reflect.PtrTo(ft).Implements(stringerType)
+}
+
+func indirectType(e reflect.Type) reflect.Type {
+	for e.Kind() == reflect.Ptr {
+		e = e.Elem()
+	}
+
+	return e
+}
+
+// getElementType determines the struct (or map) type of the elements of a slice.
+func getElementType(vv reflect.Value) (reflect.Type, error) {
+	e := indirectType(vv.Type().Elem())
+
+	switch e.Kind() {
+	case reflect.Struct, reflect.Map:
+		return e, nil
+	case reflect.Interface:
+		// infer the type from the first non-nil element
+		for i := 0; i < vv.Len(); i++ {
+			item, ok := indirectValue(vv.Index(i))
+			if !ok {
+				continue
+			}
+
+			if item.Kind() != reflect.Struct && item.Kind() != reflect.Map {
+				return item.Type(), fmt.Errorf("invalid kind %s", item.Kind())
+			}
+
+			return item.Type(), nil
+		}
+
+		return e, errors.New("all elements are nil")
 	default:
 		return e, fmt.Errorf("invalid kind %s", e.Kind())
 	}
+}
+
+// indirectValue resolves pointers and interfaces. It returns false if a nil value is found.
+func indirectValue(v reflect.Value) (reflect.Value, bool) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return v, false
+		}
+
+		v = v.Elem()
+	}
 
-	return e, nil
+	return v, v.IsValid()
 }
@@ -1,8 +1,10 @@
 package tablewriter
 
 import (
+	"bytes"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -149,8 +151,19 @@ func TestStructs(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name:    "the first element is nil",
-			values:  []*testType{nil, nil},
+			name:   "infer fields when the first element is nil",
+			values: []*testType{nil, {A: "A", B: 3}},
+			want: `
++---+---+------------------+-------+
+| A | B |        C         |  DD   |
++---+---+------------------+-------+
+| A | 3 | testStringerType | false |
++---+---+------------------+-------+
+`,
+		},
+		{
+			name:    "all elements are nil",
+			values:  []interface{}{nil, (*testType)(nil)},
 			wantErr: true,
 		},
 		{
@@ -202,3 +215,208 @@ func TestStructs(t *testing.T) {
 		})
 	}
 }
+
+func TestStructsTags(t *testing.T) {
+	t.Parallel()
+
+	type product struct {
+		Name     string  `json:"name" tablewriter:"Product,align=center"`
+		Price    float64 `json:"price" tablewriter:"Price,align=right,format=%.2f"`
+		Discount float64 `json:"discount,omitempty" tablewriter:",omitempty,format=%.1f%%"`
+		Code     string  `json:"-" tablewriter:"Code,width=4"`
+		Internal string  `json:"internal" tablewriter:"-"`
+		secret   string
+	}
+
+	products := []product{
+		{Name: "tea", Price: 3.5, Code: "T001 XL", Internal: "x", secret: "y"},
+		{Name: "coffee", Price: 12, Discount: 7.5, Code: "C01", Internal: "x"},
+	}
+
+	t.Run("should apply tag options and skip fields", func(t *testing.T) {
+		table, buf := NewBuffered(WithTitledHeader(false))
+		require.NoError(t, table.SetStructs(products))
+		require.NoError(t, table.Render())
+
+		const want = `+---------+-------+----------+------+
+| Product | Price | Discount | Code |
++---------+-------+----------+------+
+|   tea   |  3.50 |          | T001 |
+|         |       |          | XL   |
+| coffee  | 12.00 |     7.5% | C01  |
++---------+-------+----------+------+
+`
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should use another tag key and order columns", func(t *testing.T) {
+		table, buf := NewBuffered(
+			WithTitledHeader(false),
+			WithStructTag("json"),
+			WithStructColumns("price", "internal", "name"),
+		)
+		require.NoError(t, table.SetStructs(products))
+		require.NoError(t, table.Render())
+
+		const want = `+-------+----------+--------+
+| price | internal |  name  |
++-------+----------+--------+
+|   3.5 | x        | tea    |
+|    12 | x        | coffee |
++-------+----------+--------+
+`
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should let explicit options take precedence over tags", func(t *testing.T) {
+		table, _ := NewBuffered(WithColAlignment(map[int]HAlignment{1: AlignLeft}))
+		require.NoError(t, table.SetStructs(products))
+
+		require.Equal(t, AlignCenter, table.perColumnAlign[0])
+		require.Equal(t, AlignLeft, table.perColumnAlign[1])
+		require.Equal(t, 4, table.colMaxWidth[3])
+	})
+
+	t.Run("should report invalid tags and columns", func(t *testing.T) {
+		type invalid struct {
+			A string `tablewriter:"A,align=diagonal"`
+		}
+
+		table, _ := NewBuffered()
+		require.Error(t, table.SetStructs([]invalid{{}}))
+
+		table, _ = NewBuffered(WithStructColumns("Unknown"))
+		require.Error(t, table.SetStructs(products))
+	})
+}
+
+func TestFromSlice(t *testing.T) {
+	t.Parallel()
+
+	type (
+		address struct {
+			Street string
+			City   string
+		}
+
+		audit struct {
+			Author string
+		}
+
+		Named struct {
+			ID int `tablewriter:"Id"`
+		}
+
+		person struct {
+			Named
+			*audit
+			Name    string
+			Address address `tablewriter:"Addr"`
+			Since   time.Time
+		}
+	)
+
+	people := []person{
+		{Named: Named{ID: 1}, audit: &audit{Author: "fred"}, Name: "John", Address: address{City: "Paris"}},
+		{Named: Named{ID: 2}, Name: "Kathy", Since: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	t.Run("should derive the header from an empty slice", func(t *testing.T) {
+		table, err := FromSlice([]*person{})
+		require.NoError(t, err)
+
+		require.Equal(t, []string{"Id", "Author", "Name", "Addr", "Since"}, table.header)
+		require.Empty(t, table.rows)
+	})
+
+	t.Run("should flatten embedded structs", func(t *testing.T) {
+		table, err := FromSlice(people)
+		require.NoError(t, err)
+
+		require.Equal(t, []string{"Id", "Author", "Name", "Addr", "Since"}, table.header)
+		require.Equal(t, []string{"1", "fred", "John", "{ Paris}", "0001-01-01 00:00:00 +0000 UTC"}, table.rows[0])
+		require.Equal(t, []string{"2", "nil", "Kathy", "{ }", "2022-01-01 00:00:00 +0000 UTC"}, table.rows[1])
+	})
+
+	t.Run("should flatten nested structs", func(t *testing.T) {
+		table, err := FromSlice(people,
+			WithStructFlatten(true),
+			WithStructColumns("Name", "Addr.City", "Author"),
+		)
+		require.NoError(t, err)
+
+		require.Equal(t, []string{"Name", "Addr.City", "Author"}, table.header)
+		require.Equal(t, [][]string{
+			{"John", "Paris", "fred"},
+			{"Kathy", "", "nil"},
+		}, table.rows)
+	})
+
+	t.Run("should not flatten recursive structs", func(t *testing.T) {
+		type (
+			node struct {
+				Name   string
+				Parent *node
+			}
+
+			edge struct {
+				From node
+				To   *node
+			}
+		)
+
+		root := &node{Name: "root"}
+		nodes := []node{*root, {Name: "child", Parent: root}}
+
+		table, err := FromSlice(nodes, WithStructFlatten(true))
+		require.NoError(t, err)
+
+		require.Equal(t, []string{"Name", "Parent"}, table.header)
+		require.Equal(t, [][]string{
+			{"root", "nil"},
+			{"child", "{root <nil>}"},
+		}, table.rows)
+
+		table, err = FromSlice([]edge{{From: nodes[1], To: root}}, WithStructFlatten(true))
+		require.NoError(t, err)
+
+		require.Equal(t, []string{"From.Name", "From.Parent", "To.Name", "To.Parent"}, table.header)
+		require.Equal(t, [][]string{{"child", "{root <nil>}", "root", "nil"}}, table.rows)
+	})
+
+	t.Run("should render maps with stable keys", func(t *testing.T) {
+		records := []map[string]interface{}{
+			{"name": "John", "rating": 500},
+			{"name": "Kathy", "sign": "The Bad", "extra": nil},
+			nil,
+		}
+
+		for i := 0; i < 10; i++ {
+			var buf bytes.Buffer
+			table, err := FromSlice(records, WithWriter(&buf), WithTitledHeader(false))
+			require.NoError(t, err)
+			require.NoError(t, table.Render())
+
+			const want = `+-------+-------+--------+---------+
+| extra | name  | rating |  sign   |
++-------+-------+--------+---------+
+|       | John  |    500 |         |
+| nil   | Kathy |        | The Bad |
++-------+-------+--------+---------+
+`
+			checkEqual(t, buf.String(), want)
+		}
+
+		table, err := FromSlice(records, WithStructColumns("sign", "name"))
+		require.NoError(t, err)
+		require.Equal(t, [][]string{{"", "John"}, {"The Bad", "Kathy"}}, table.rows)
+	})
+
+	t.Run("should report invalid element types", func(t *testing.T) {
+		_, err := FromSlice([]int{1, 2})
+		require.Error(t, err)
+
+		_, err = FromSlice([]interface{}{})
+		require.Error(t, err)
+	})
+}
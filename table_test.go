@@ -250,7 +250,7 @@ func TestPrintingInMarkdown(t *testing.T) {
 	table.Render()
 
 	want := `|   DATE   |       DESCRIPTION        | CV2  | AMOUNT |
-|----------|--------------------------|------|--------|
+|----------|--------------------------|-----:|-------:|
 | 1/1/2014 | Domain name              | 2233 | $10.98 |
 | 1/1/2014 | January Hosting          | 2233 | $54.95 |
 | 1/4/2014 | February Hosting         | 2233 | $51.00 |
@@ -760,6 +760,47 @@ func TestAnsiStrip(t *testing.T) {
 	)
 }
 
+func TestAnsiWrap(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should preserve styles across wrapped lines", func(t *testing.T) {
+		table, buf := NewBuffered(
+			WithHeader([]string{"Status"}),
+			WithColMaxWidth(0, 6),
+			WithRows([][]string{{"\033[31mfailed on retry\033[0m"}}),
+		)
+		require.NoError(t, table.Render())
+
+		const want = "+--------+\n" +
+			"| STATUS |\n" +
+			"+--------+\n" +
+			"| \033[31mfailed\033[0m |\n" +
+			"| \033[31mon\033[0m     |\n" +
+			"| \033[31mretry\033[0m  |\n" +
+			"+--------+\n"
+
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should preserve styles across paragraphs without wrapping", func(t *testing.T) {
+		table, buf := NewBuffered(
+			WithWrap(false),
+			WithHeader([]string{"Status"}),
+			WithRows([][]string{{"\033[32mok\nfine\033[0m"}}),
+		)
+		require.NoError(t, table.Render())
+
+		const want = "+--------+\n" +
+			"| STATUS |\n" +
+			"+--------+\n" +
+			"| \033[32mok\033[0m     |\n" +
+			"| \033[32mfine\033[0m   |\n" +
+			"+--------+\n"
+
+		checkEqual(t, buf.String(), want)
+	})
+}
+
 func TestSubclass(t *testing.T) {
 	t.Parallel()
 
@@ -990,6 +1031,125 @@ func TestClearRows(t *testing.T) {
 
 		checkEqual(t, buf.String(), want, "table clear rows failed")
 	})
+
+	t.Run("should not retain the columns of rows cleared after rendering", func(t *testing.T) {
+		table, buf := NewBuffered(WithHeader([]string{"A", "B"}))
+		table.Append([]string{"1", "2", "3"})
+		require.NoError(t, table.Render())
+		require.Equal(t, []string{"A", "B"}, table.Header())
+		require.Equal(t, [][]string{{"1", "2", "3"}}, table.Rows())
+
+		buf.Reset()
+		table.ClearRows()
+		table.Append([]string{"4", "5"})
+		require.NoError(t, table.Render())
+
+		const want = `+---+---+
+| A | B |
++---+---+
+| 4 | 5 |
++---+---+
+`
+		checkEqual(t, buf.String(), want)
+		require.Equal(t, []string{"A", "B"}, table.Header())
+	})
+}
+
+func TestEmptyTable(t *testing.T) {
+	t.Parallel()
+
+	table, buf := NewBuffered()
+	require.NoError(t, table.Render())
+
+	checkEqual(t, buf.String(), "+\n+\n")
+}
+
+func TestRenderTwice(t *testing.T) {
+	t.Parallel()
+
+	data := [][]string{
+		{"1/1/2014", "Domain name", "2233", "$10.98"},
+	}
+
+	t.Run("should render the same table twice", func(t *testing.T) {
+		table, buf := NewBuffered(
+			WithHeader([]string{"Date", "Description", "CV2", "Amount"}),
+			WithFooter([]string{"", "", "Total", "$10.98"}),
+			WithRows(data),
+		)
+		require.NoError(t, table.Render())
+		first := buf.String()
+		buf.Reset()
+
+		require.NoError(t, table.Render())
+		checkEqual(t, buf.String(), first)
+	})
+
+	t.Run("should render again after appending rows", func(t *testing.T) {
+		table, buf := NewBuffered(
+			WithHeader([]string{"Date", "Description", "CV2", "Amount"}),
+			WithRows(data),
+		)
+		require.NoError(t, table.Render())
+		buf.Reset()
+
+		table.Append([]string{"1/1/2014", "January Hosting", "2233", "$54.95"})
+		require.NoError(t, table.Render())
+
+		const want = `+----------+-----------------+------+--------+
+|   DATE   |   DESCRIPTION   | CV2  | AMOUNT |
++----------+-----------------+------+--------+
+| 1/1/2014 | Domain name     | 2233 | $10.98 |
+| 1/1/2014 | January Hosting | 2233 | $54.95 |
++----------+-----------------+------+--------+
+`
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should shrink columns after clearing rows", func(t *testing.T) {
+		table, buf := NewBuffered(
+			WithHeader([]string{"Date", "Description", "CV2", "Amount"}),
+			WithRows([][]string{
+				{"1/4/2014", "February Extra Bandwidth", "2233", "$30.00"},
+			}),
+		)
+		require.NoError(t, table.Render())
+		buf.Reset()
+
+		table.ClearRows()
+		for _, row := range data {
+			table.Append(row)
+		}
+		require.NoError(t, table.Render())
+
+		const want = `+----------+-------------+------+--------+
+|   DATE   | DESCRIPTION | CV2  | AMOUNT |
++----------+-------------+------+--------+
+| 1/1/2014 | Domain name | 2233 | $10.98 |
++----------+-------------+------+--------+
+`
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should render new content after reset", func(t *testing.T) {
+		table, buf := NewBuffered(
+			WithHeader([]string{"Date", "Description", "CV2", "Amount"}),
+			WithFooter([]string{"", "", "Total", "$10.98"}),
+			WithRows(data),
+		)
+		require.NoError(t, table.Render())
+		buf.Reset()
+
+		table.Reset()
+		table.Append([]string{"A", "B"})
+		require.NoError(t, table.Render())
+
+		const want = `+---+---+
+| A | B |
++---+---+
+`
+		checkEqual(t, buf.String(), want)
+	})
 }
 
 func TestNoWrap(t *testing.T) {
@@ -1319,3 +1479,60 @@ func TestMaxTableWidth(t *testing.T) {
 		// checkEqual(t, buf.String(), expected)
 	})
 }
+
+type failingWriter struct {
+	calls int
+}
+
+func (w *failingWriter) Write(_ []byte) (int, error) {
+	w.calls++
+
+	return 0, io.ErrClosedPipe
+}
+
+func TestRenderError(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should report write errors", func(t *testing.T) {
+		out := &failingWriter{}
+		table := New(
+			WithWriter(out),
+			WithHeader([]string{"Name", "Sign", "Rating"}),
+			WithRows([][]string{
+				{"A", "The Good", "500"},
+				{"B", "The Very very Bad Man", "288"},
+			}),
+			WithCaption("Movie ratings."),
+		)
+
+		err := table.Render()
+		require.ErrorIs(t, err, io.ErrClosedPipe)
+		require.Equal(t, 1, out.calls, "expected rendering to stop at the first failed write")
+	})
+
+	t.Run("should render with a single write", func(t *testing.T) {
+		out := &countingWriter{}
+		table := New(
+			WithWriter(out),
+			WithHeader([]string{"Name", "Sign", "Rating"}),
+			WithRows([][]string{
+				{"A", "The Good", "500"},
+				{"B", "The Very very Bad Man", "288"},
+			}),
+		)
+
+		require.NoError(t, table.Render())
+		require.Equal(t, 1, out.calls)
+	})
+}
+
+type countingWriter struct {
+	bytes.Buffer
+	calls int
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.calls++
+
+	return w.Buffer.Write(p)
+}
@@ -61,7 +61,7 @@ func (w *RowWrapper) WrapCell(row, col int) []string {
 		return []string{w.matrix[row][col]}
 	}
 
-	return w.columns[col].cells[row].content
+	return PreserveEscapes(w.columns[col].cells[row].content)
 }
 
 func (w *RowWrapper) prepare() {
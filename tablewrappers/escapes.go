@@ -0,0 +1,115 @@
+package tablewrappers
+
+import (
+	"regexp"
+	"strings"
+)
+
+const (
+	sgrReset  = "\033[0m"
+	linkClose = "\033]8;;\033\\"
+)
+
+var (
+	// escapeSequence matches the escape sequences that are tracked across lines:
+	// SGR sequences (colors, bold face, ...) and OSC 8 hyperlinks, terminated by BEL or ST.
+	escapeSequence = regexp.MustCompile("\033\\[([0-9;]*)m|\033\\]8;[^;\007\033]*;([^\007\033]*)(?:\007|\033\\\\)")
+)
+
+// PreserveEscapes makes every line of a multi-line cell self-contained with regard to ANSI styles and hyperlinks.
+//
+// SGR styles and OSC 8 hyperlinks that are still active at the end of a line are closed, then reopened at the
+// beginning of the next non-empty line. This way, padding and separators between lines are never styled.
+func PreserveEscapes(lines []string) []string {
+	if !hasEscapes(lines) {
+		return lines
+	}
+
+	var (
+		styles []string // active SGR sequences
+		link   string   // active hyperlink
+	)
+
+	out := make([]string, len(lines))
+	for i, line := range lines {
+		if len(line) == 0 {
+			continue
+		}
+
+		prefix := link + strings.Join(styles, "")
+
+		for _, match := range escapeSequence.FindAllStringSubmatchIndex(line, -1) {
+			sequence := line[match[0]:match[1]]
+
+			if match[2] >= 0 { // SGR sequence
+				first, rest, _ := strings.Cut(line[match[2]:match[3]], ";")
+				switch {
+				case !isReset(first):
+					styles = append(styles, sequence)
+				case rest == "":
+					styles = nil
+				default:
+					// reset, then new attributes
+					styles = []string{sequence}
+				}
+
+				continue
+			}
+
+			// OSC 8 hyperlink: an empty URI closes the link
+			if match[4] == match[5] {
+				link = ""
+			} else {
+				link = sequence
+			}
+		}
+
+		var suffix string
+		if len(styles) > 0 {
+			suffix += sgrReset
+		}
+
+		if len(link) > 0 {
+			suffix += linkClose
+		}
+
+		out[i] = prefix + line + suffix
+	}
+
+	return out
+}
+
+// isReset tells if a SGR parameter resets all attributes, e.g. "", "0" or "00".
+func isReset(param string) bool {
+	return strings.Trim(param, "0") == ""
+}
+
+func hasEscapes(lines []string) bool {
+	for _, line := range lines {
+		if strings.IndexByte(line, '\033') >= 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// escapeSpans yields the positions of escape sequences in a string.
+func escapeSpans(str string) [][]int {
+	if strings.IndexByte(str, '\033') < 0 {
+		return nil
+	}
+
+	return escapeSequence.FindAllStringIndex(str, -1)
+}
+
+// inSpans tells if the byte at position i is part of one of the spans, excluding its last byte.
+func inSpans(i int, spans [][]int) bool {
+	for _, span := range spans {
+		if i >= span[0] && i < span[1]-1 {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,99 @@
+package tablewrappers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreserveEscapes(t *testing.T) {
+	t.Parallel()
+
+	const (
+		red   = "\033[31m"
+		bold  = "\033[1m"
+		reset = "\033[0m"
+		link  = "\033]8;;https://example.com\033\\"
+	)
+
+	t.Run("should leave plain lines untouched", func(t *testing.T) {
+		t.Parallel()
+
+		lines := []string{"abc", "", "def"}
+		require.Equal(t, lines, PreserveEscapes(lines))
+	})
+
+	t.Run("should close and reopen styles on every line", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t,
+			[]string{
+				red + "The quick" + reset,
+				red + "brown" + bold + " fox" + reset,
+				"",
+				red + bold + "jumps" + reset + " over",
+				"the lazy dog",
+			},
+			PreserveEscapes([]string{
+				red + "The quick",
+				"brown" + bold + " fox",
+				"",
+				"jumps" + reset + " over",
+				"the lazy dog",
+			}),
+		)
+	})
+
+	t.Run("should restart styles after a reset with attributes", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t,
+			[]string{
+				red + "a\033[0;32mb" + reset,
+				"\033[0;32mc\033[00m",
+			},
+			PreserveEscapes([]string{red + "a\033[0;32mb", "c\033[00m"}),
+		)
+	})
+
+	t.Run("should close and reopen hyperlinks on every line", func(t *testing.T) {
+		t.Parallel()
+
+		const closeLink = "\033]8;;\033\\"
+
+		require.Equal(t,
+			[]string{
+				link + "example" + closeLink,
+				link + "site" + closeLink + " end",
+			},
+			PreserveEscapes([]string{link + "example", "site" + closeLink + " end"}),
+		)
+	})
+}
+
+func TestWrapEscapes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should measure hyperlinks by their label", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, 4, DisplayWidth("\033]8;;https://example.com\007site\033]8;;\007"))
+	})
+
+	t.Run("should wrap styled text without bleeding styles", func(t *testing.T) {
+		t.Parallel()
+
+		w := NewDefault()
+		got := w.WrapString("\033[31mred text here\033[0m", 8)
+		require.Equal(t, []string{"\033[31mred text\033[0m", "\033[31mhere\033[0m"}, got)
+	})
+
+	t.Run("should not break inside escape sequences", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t,
+			[]string{"\033[1;31mab;", "cd\033[0m"},
+			breakAtFunc("\033[1;31mab;cd\033[0m", func(r rune) bool { return r == ';' }),
+		)
+	})
+}
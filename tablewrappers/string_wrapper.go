@@ -33,5 +33,5 @@ func (w *DefaultWrapper) WrapString(s string, limit int) []string {
 		}
 	*/
 
-	return lines
+	return PreserveEscapes(lines)
 }
@@ -6,11 +6,12 @@ import (
 	"github.com/mattn/go-runewidth"
 )
 
-var ansi = regexp.MustCompile("\033\\[(?:[0-9]{1,3}(?:;[0-9]{1,3})*)?[m|K]")
+// ansi matches CSI sequences for colors and line erasure, as well as OSC 8 hyperlinks.
+var ansi = regexp.MustCompile("\033\\[(?:[0-9]{1,3}(?:;[0-9]{1,3})*)?[m|K]|\033\\]8;[^\007\033]*(?:\007|\033\\\\)")
 
 // DisplayWidth yields the size of a string when rendered on a terminal.
 //
-// ANSI escape sequences are discared.
+// ANSI escape sequences and hyperlinks are discarded.
 func DisplayWidth(str string) int {
 	return displayWidth(str)
 }
@@ -142,13 +142,14 @@ func wordBreaker(splitter Splitter) func(string, int) []string {
 
 // breakAtFunc works like strings.FieldsFunc, but retain separators.
 //
-// Break always happen _after_ the separator.
+// Break always happen _after_ the separator. Escape sequences are never broken.
 func breakAtFunc(word string, isBreak Splitter) []string {
 	parts := make([]string, 0, len(word))
 	previous := 0
+	escapes := escapeSpans(word)
 
 	for i, r := range word {
-		if isBreak(r) {
+		if isBreak(r) && !inSpans(i, escapes) {
 			parts = append(parts, word[previous:i+1])
 			previous = i + 1
 		}
@@ -0,0 +1,99 @@
+package tablewriter
+
+import "strings"
+
+// guides drawn in the first column of tree rows
+const (
+	treeBranch   = "├─ "
+	treeLast     = "└─ "
+	treeVertical = "│  "
+	treeBlank    = "   "
+)
+
+// treeGuide is the indentation of a row of a tree: the first line of the cell shows the branch to the node,
+// and the other lines continue the guides of the ancestors.
+type treeGuide struct {
+	first, next string
+}
+
+// AppendTree appends a row as a node of a tree, at some depth: 0 for a root, 1 for its children, etc.
+//
+// Rows are rendered in the order they are appended: the parent of a node is the previous row with a lower depth.
+// In text tables, the first column shows the hierarchy with guides, like the "tree" command, and guides continue
+// through multi-lines cells. The indentation counts toward the maximum width of the first column.
+func (t *Table) AppendTree(depth int, row []string) {
+	if t.rowDepths == nil {
+		t.rowDepths = make(map[int]int)
+	}
+
+	t.rowDepths[len(t.rows)] = max(depth, 0)
+	t.Append(row)
+}
+
+// prepareTree determines the guides of tree rows.
+//
+// A node is the last child of its parent if no sibling follows before a row with a lower depth.
+func (t *Table) prepareTree() {
+	t.treeGuides = nil
+
+	if len(t.rowDepths) == 0 {
+		return
+	}
+
+	// depths may increase only by one level from a row to the next one
+	depths := make([]int, len(t.rows))
+	for i := range depths {
+		depths[i] = t.rowDepths[i]
+		if i > 0 && depths[i] > depths[i-1]+1 {
+			depths[i] = depths[i-1] + 1
+		}
+	}
+
+	isLast := make([]bool, len(t.rows))
+	seen := make(map[int]bool)
+	for i := len(depths) - 1; i >= 0; i-- {
+		depth := depths[i]
+		isLast[i] = !seen[depth]
+		seen[depth] = true
+
+		for deeper := range seen {
+			if deeper > depth {
+				delete(seen, deeper)
+			}
+		}
+	}
+
+	t.treeGuides = make(map[int]treeGuide, len(t.rows))
+	hasMore := make(map[int]bool) // by depth: the latest node at this depth has a following sibling, i.e. a guide continues
+	for i, depth := range depths {
+		hasMore[depth] = !isLast[i]
+
+		if depth == 0 {
+			continue
+		}
+
+		var ancestors strings.Builder
+		for level := 1; level < depth; level++ {
+			ancestors.WriteString(stringIf(hasMore[level], treeVertical, treeBlank))
+		}
+
+		t.treeGuides[i] = treeGuide{
+			first: ancestors.String() + stringIf(isLast[i], treeLast, treeBranch),
+			next:  ancestors.String() + stringIf(isLast[i], treeBlank, treeVertical),
+		}
+	}
+}
+
+// indent prefixes the lines of a cell with the guides of a tree row.
+func (g treeGuide) indent(lines []string) []string {
+	if len(lines) == 0 {
+		return []string{g.first}
+	}
+
+	indented := make([]string, len(lines))
+	for i, line := range lines {
+		indented[i] = stringIf(i == 0, g.first, g.next) + line
+	}
+
+	return indented
+}
@@ -0,0 +1,93 @@
+package tablewriter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTree(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should render guides in the first column", func(t *testing.T) {
+		table, buf := NewBuffered(
+			WithHeader([]string{"Module", "Version"}),
+			WithStyle(StyleLight),
+			WithColMaxWidth(0, 12),
+		)
+		table.AppendTree(0, []string{"app", "v1"})
+		table.AppendTree(1, []string{"aurora", "v4.0.0"})
+		table.AppendTree(2, []string{"a very long dependency name", "v0.1"})
+		table.AppendTree(2, []string{"x", "v0.2"})
+		table.AppendTree(1, []string{"testify", "v1.8"})
+		table.AppendTree(2, []string{"yaml", "v3"})
+		table.AppendTree(3, []string{"check", "v1"})
+		table.AppendTree(0, []string{"tool", "v2"})
+		require.NoError(t, table.Render())
+
+		const want = `┌──────────────────┬─────────┐
+│      MODULE      │ VERSION │
+├──────────────────┼─────────┤
+│ app              │ v1      │
+│ ├─ aurora        │ v4.0.0  │
+│ │  ├─ a very     │ v0.1    │
+│ │  │  long       │         │
+│ │  │  dependency │         │
+│ │  │  name       │         │
+│ │  └─ x          │ v0.2    │
+│ └─ testify       │ v1.8    │
+│    └─ yaml       │ v3      │
+│       └─ check   │ v1      │
+│ tool             │ v2      │
+└──────────────────┴─────────┘
+`
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should count guides toward the maximum width of the column", func(t *testing.T) {
+		table, buf := NewBuffered(WithColMaxWidth(0, 8))
+		table.AppendTree(0, []string{"root"})
+		table.AppendTree(1, []string{"abcd efgh"})
+		table.AppendTree(2, []string{"ab cd ef"})
+		require.NoError(t, table.Render())
+
+		const want = `+----------+
+| root     |
+| └─ abcd  |
+|    efgh  |
+|    └─ ab |
+|       cd |
+|       ef |
++----------+
+`
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should continue guides through multi-lines cells", func(t *testing.T) {
+		table, buf := NewBuffered(WithWrap(false), WithAllBorders(false))
+		table.AppendTree(0, []string{"root"})
+		table.AppendTree(1, []string{"first\nchild"})
+		table.AppendTree(2, []string{"leaf"})
+		table.AppendTree(1, []string{"last\nchild"})
+		require.NoError(t, table.Render())
+
+		const want = `  root
+  ├─ first
+  │  child
+  │  └─ leaf
+  └─ last
+     child
+`
+		checkEqual(t, buf.String(), want)
+	})
+
+	t.Run("should limit the increase of depth to one level", func(t *testing.T) {
+		table, _ := NewBuffered()
+		table.AppendTree(0, []string{"root"})
+		table.AppendTree(3, []string{"child"})
+		table.Append([]string{"other root"})
+		table.prepare()
+
+		require.Equal(t, map[int]treeGuide{1: {first: treeLast, next: treeBlank}}, table.treeGuides)
+	})
+}
@@ -7,6 +7,12 @@
 
 package tablewriter
 
+import (
+	"strings"
+
+	wrap "github.com/fredbi/tablewriter/tablewrappers"
+)
+
 type (
 	transformer    func(string) string
 	colPadder      func(string, int, int) string
@@ -15,6 +21,22 @@ type (
 
 func identity(in string) string { return in }
 
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
+
 func stringIf(cond bool, ifTrue, ifFalse string) string {
 	if cond {
 		return ifTrue
@@ -24,15 +46,64 @@ func stringIf(cond bool, ifTrue, ifFalse string) string {
 }
 
 // enforce all cells in a row to have the same number of lines
-func normalizeRowHeight(columns [][]string, height int) [][]string {
+//
+// Short cells are padded with empty lines, according to their vertical alignment. With a nil valigner,
+// cells are aligned to the top.
+func normalizeRowHeight(columns [][]string, height int, valigner func(col int) VAlignment) [][]string {
 	for i, rowLines := range columns {
-		currentHeight := len(rowLines)
-		padHeight := height - currentHeight
-
-		for n := 0; n < padHeight; n++ {
-			columns[i] = append(columns[i], "")
+		align := VAlignTop
+		if valigner != nil {
+			align = valigner(i)
 		}
+
+		columns[i] = valignLines(rowLines, height, align)
 	}
 
 	return columns
 }
+
+// valignLines pads the lines of a cell with empty lines up to some height, according to a vertical alignment.
+func valignLines(lines []string, height int, align VAlignment) []string {
+	padHeight := height - len(lines)
+	if padHeight <= 0 {
+		return lines
+	}
+
+	var above int
+	switch align {
+	case VAlignMiddle:
+		above = padHeight / 2
+	case VAlignBottom:
+		above = padHeight
+	}
+
+	padded := make([]string, above, height)
+	padded = append(padded, lines...)
+
+	return append(padded, make([]string, padHeight-above)...)
+}
+
+// isBlankCell tells if all the lines of a cell are blank.
+func isBlankCell(lines []string) bool {
+	for _, line := range lines {
+		if len(strings.TrimRightFunc(line, wrap.BlankSplitter)) > 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// splitLines splits the content of a cell into non-empty lines.
+func splitLines(in string) []string {
+	return strings.FieldsFunc(in, wrap.LineSplitter)
+}
+
+// normalizeColumns yields a copy of some values with exactly cols columns, adding trailing blank columns
+// or removing trailing columns.
+func normalizeColumns(values []string, cols int) []string {
+	normalized := make([]string, cols)
+	copy(normalized, values)
+
+	return normalized
+}
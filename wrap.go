@@ -6,7 +6,11 @@ import (
 	wrap "github.com/fredbi/tablewriter/tablewrappers"
 )
 
+// prepare computes the layout of the table.
+//
+// All the prepared state is recomputed from scratch, so a table may be rendered several times.
 func (t *Table) prepare() {
+	t.resetLayout()
 	t.setNumColumns()
 	t.fillAlignments()
 	t.fillMaxWidths()
@@ -14,12 +18,15 @@ func (t *Table) prepare() {
 	// evaluate wrapped content
 	t.setWrapper()
 
-	for i := range t.header {
+	for i := range t.headerValues {
 		lines := t.parseCell(i, headerRowIdx)
 		t.headers = append(t.headers, lines)
 	}
 
-	for i, cells := range t.rows {
+	t.prepareSpans()
+	t.prepareTree()
+
+	for i, cells := range t.rowValues {
 		var rowLines [][]string
 		for j := range cells {
 			rowLines = append(rowLines, t.parseCell(j, i))
@@ -27,9 +34,43 @@ func (t *Table) prepare() {
 		t.lines = append(t.lines, rowLines)
 	}
 
-	for i := range t.footer {
-		lines := t.parseCell(i, footerRowIdx)
-		t.footers = append(t.footers, lines)
+	t.layoutSpans()
+
+	t.prepareFooterRows()
+
+	for i, values := range t.footerValues {
+		rowLines := make([][]string, 0, len(values))
+		for j, value := range values {
+			if i == 0 && len(t.footer) > 0 {
+				rowLines = append(rowLines, t.parseCell(j, footerRowIdx))
+
+				continue
+			}
+
+			// computed rows of the footer are not wrapped
+			lines := wrap.PreserveEscapes(splitLines(value))
+			t.setColWidth(j, wrap.CellWidth(lines))
+			rowLines = append(rowLines, lines)
+		}
+
+		t.footers = append(t.footers, rowLines)
+	}
+
+	t.prepareRowGroups()
+	t.prepareHeaderGroups()
+}
+
+// resetLayout discards any layout state computed by a previous rendering.
+func (t *Table) resetLayout() {
+	t.lines = [][][]string{}
+	t.headers = [][]string{}
+	t.footers = [][][]string{}
+	t.rowMaxHeight = make(map[int]int)
+	t.colWidth = make(map[int]int, len(t.colMinWidth))
+	t.columnRanges = nil
+
+	for col, width := range t.colMinWidth {
+		t.colWidth[col] = width
 	}
 }
 
@@ -37,7 +78,15 @@ func (t *Table) setWrapper() {
 	if t.cellWrapperFactory != nil {
 		// wrap is enabled with some wrapper
 		wrapper := t.cellWrapperFactory(t)
+		cellWrapper, isDefault := wrapper.(*wrap.DefaultCellWrapper)
 		t.cellWrapper = func(row, col int) []string {
+			if guide, isTree := t.treeGuides[row]; isTree && col == 0 && isDefault && t.colMaxWidth[col] > 0 {
+				// the guides of tree rows count toward the maximum width of the column
+				limit := max(t.colMaxWidth[col]-wrap.DisplayWidth(guide.first), 1)
+
+				return cellWrapper.WrapString(t.rowValues[row][col], limit)
+			}
+
 			rowOffset := row
 
 			switch {
@@ -61,15 +110,15 @@ func (t *Table) setWrapper() {
 	}
 
 	// wrap is disabled: set a noop wrapper. This preserves blank space and paragraphs.
-	paragrapher := func(s string) []string { return strings.FieldsFunc(s, wrap.LineSplitter) }
+	paragrapher := func(s string) []string { return wrap.PreserveEscapes(strings.FieldsFunc(s, wrap.LineSplitter)) }
 	t.cellWrapper = func(row, col int) []string {
 		switch {
 		case row == headerRowIdx:
-			return paragrapher(t.header[col])
+			return paragrapher(t.headerValues[col])
 		case row == footerRowIdx:
-			return paragrapher(t.footer[col])
+			return paragrapher(t.footerValues[0][col])
 		default:
-			return paragrapher(t.rows[row][col])
+			return paragrapher(t.rowValues[row][col])
 		}
 	}
 }
@@ -78,8 +127,24 @@ func (t *Table) setWrapper() {
 // If wrapping is enabled, the content of the cell is wrapped.
 //
 // Works also for header and footer with special row indices.
+//
+// The first cell of tree rows is indented with guides, which are accounted for in the width of the column.
+//
+// The layout of cells spanning several rows or columns is deferred (see layoutSpans).
 func (t *Table) parseCell(col, row int) []string {
+	if span, isSpanned := t.spanAt(row, col); isSpanned {
+		if span.row != row || span.col != col {
+			// covered by a span
+			return nil
+		}
+
+		return t.cellWrapper(row, col)
+	}
+
 	paragraphs := t.cellWrapper(row, col)
+	if guide, isTree := t.treeGuides[row]; isTree && col == 0 {
+		paragraphs = guide.indent(paragraphs)
+	}
 
 	t.setColWidth(col, wrap.CellWidth(paragraphs))
 	t.setRowHeight(row, len(paragraphs))